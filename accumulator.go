@@ -0,0 +1,52 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errNotInSet = errors.New("polynomial: the element is not a root of the characteristic polynomial")
+
+// product() multiplies ps pairwise in a balanced tree, so the operands stay of similar degree
+func product(ps []Poly, m *big.Int) Poly {
+	switch len(ps) {
+	case 0:
+		return NewPolyInts(1)
+	case 1:
+		// reduced like the products, so the result does not depend on the number of factors
+		q := ps[0].Clone(0)
+		q.sanitize(m)
+		return q
+	}
+	half := len(ps) / 2
+	return product(ps[:half], m).Mul(product(ps[half:], m), m)
+}
+
+// xPlusConst generates P = (x+a) for the given a
+func xPlusConst(a *big.Int) Poly {
+	return Poly{new(big.Int).Set(a), big.NewInt(1)}
+}
+
+// CharacteristicPoly() returns (x + s_1)(x + s_2)...(x + s_n) using a product tree
+func CharacteristicPoly(set []*big.Int, m *big.Int) Poly {
+	ps := make([]Poly, len(set))
+	for i, s := range set {
+		ps[i] = xPlusConst(s)
+	}
+	return product(ps, m)
+}
+
+// AddElement() returns P * (x + s), the characteristic polynomial after adding s to the set
+func (p Poly) AddElement(s, m *big.Int) Poly {
+	return p.Clone(0).Mul(xPlusConst(s), m)
+}
+
+// RemoveElement() returns P / (x + s), the characteristic polynomial after removing s from the set
+// It fails if s is not in the set, i.e. the division is not exact
+func (p Poly) RemoveElement(s, m *big.Int) (Poly, error) {
+	quo, rem := p.Clone(0).Div(xPlusConst(s), m)
+	if !rem.isZero() || quo.isZero() {
+		return nil, errNotInSet
+	}
+	return quo, nil
+}
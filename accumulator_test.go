@@ -0,0 +1,59 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCharacteristicPoly(t *testing.T) {
+	m := big.NewInt(179424691)
+	cases := []struct {
+		set []*big.Int
+		ans Poly
+	}{
+		{
+			nil,
+			NewPolyInts(1),
+		},
+		{
+			[]*big.Int{big.NewInt(3)},
+			NewPolyInts(3, 1),
+		},
+		{
+			[]*big.Int{big.NewInt(179424691 + 20)},
+			NewPolyInts(20, 1),
+		},
+		{
+			[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)},
+			NewPolyInts(6, 11, 6, 1),
+		},
+		{
+			[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4), big.NewInt(5)},
+			NewPolyInts(120, 274, 225, 85, 15, 1),
+		},
+	}
+	for _, c := range cases {
+		res := CharacteristicPoly(c.set, m)
+		if res.Compare(&c.ans) != 0 {
+			t.Errorf("CharacteristicPoly(%v) != %v (your answer was %v)", c.set, c.ans, res)
+		}
+	}
+}
+
+func TestAddRemoveElement(t *testing.T) {
+	m := big.NewInt(179424691)
+	set := []*big.Int{big.NewInt(10), big.NewInt(20), big.NewInt(30)}
+	p := CharacteristicPoly(set, m)
+	q := p.AddElement(big.NewInt(40), m)
+	ans := CharacteristicPoly(append(set, big.NewInt(40)), m)
+	if q.Compare(&ans) != 0 {
+		t.Errorf("AddElement() = %v, want %v", q, ans)
+	}
+	r, err := q.RemoveElement(big.NewInt(40), m)
+	if err != nil || r.Compare(&p) != 0 {
+		t.Errorf("RemoveElement() = %v, want %v (error: %v)", r, p, err)
+	}
+	if _, err := p.RemoveElement(big.NewInt(40), m); err == nil {
+		t.Errorf("RemoveElement() of a non-member should fail")
+	}
+}
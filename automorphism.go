@@ -0,0 +1,34 @@
+package polynomial
+
+import "math/big"
+
+// ApplyAutomorphism() returns P(x^k) in Z_m[x]/(x^n + 1) by moving the coefficient of x^i to x^(ik mod 2n) and
+// negating it when ik mod 2n >= n, since x^n = -1
+// For odd k this is the Galois automorphism of the 2n-th cyclotomic ring (k = 2n - 1 is conjugation, powers of
+// 5 give rotations); even k are allowed and merely collide indices
+// modulo m can be nil
+func ApplyAutomorphism(p Poly, k int, n int, m *big.Int) Poly {
+	if n < 1 {
+		panic("polynomial: the ring degree must be positive")
+	}
+	p = ReduceNegacyclic(p, n, m)
+	k %= 2 * n
+	if k < 0 {
+		k += 2 * n
+	}
+	r := make(Poly, n)
+	for i := range r {
+		r[i] = new(big.Int)
+	}
+	for i, c := range p {
+		j := i * k % (2 * n)
+		if j < n {
+			r[j].Add(r[j], c)
+		} else {
+			r[j-n].Sub(r[j-n], c)
+		}
+	}
+	r.sanitize(m)
+	r.trim()
+	return r
+}
@@ -0,0 +1,41 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestApplyAutomorphism(t *testing.T) {
+	q := big.NewInt(97)
+	p := NewPolyInts(1, 2, 3, 4)
+	cases := []struct {
+		k    int
+		m    *big.Int
+		want Poly
+	}{
+		{1, nil, p},
+		// x -> x^3: 1 + 2x^3 + 3x^6 + 4x^9 = 1 - 3x^2 + 2x^3 + 4x
+		{3, nil, NewPolyInts(1, 4, -3, 2)},
+		// x -> x^7 = x^-1, and x^-i = -x^(4-i)
+		{7, nil, NewPolyInts(1, -4, -3, -2)},
+		{-1, nil, NewPolyInts(1, -4, -3, -2)},
+		{3, q, NewPolyInts(1, 4, 94, 2)},
+		{2, nil, NewPolyInts(-2, 0, -2)},
+	}
+	for _, c := range cases {
+		if got := ApplyAutomorphism(p, c.k, 4, c.m); !got.Equal(c.want) {
+			t.Errorf("ApplyAutomorphism(%v, %d) mod %v = %v, expected %v", p, c.k, c.m, got, c.want)
+		}
+	}
+	// the automorphisms form a group: applying 3 then 3 is applying 9 = 1 mod 8
+	if got := ApplyAutomorphism(ApplyAutomorphism(p, 3, 4, q), 3, 4, q); !got.Equal(p) {
+		t.Errorf("3 o 3 = %v, expected %v", got, p)
+	}
+	// and they are ring homomorphisms
+	a, b := NewPolyInts(3, 0, 5, 1), NewPolyInts(2, 7, 1)
+	ab := ReduceNegacyclic(a.Clone(0).Mul(b.Clone(0), q), 4, q)
+	want := ReduceNegacyclic(ApplyAutomorphism(a, 5, 4, q).Mul(ApplyAutomorphism(b, 5, 4, q), q), 4, q)
+	if got := ApplyAutomorphism(ab, 5, 4, q); !got.Equal(want) {
+		t.Errorf("sigma_5(ab) = %v, expected %v", got, want)
+	}
+}
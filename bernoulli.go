@@ -0,0 +1,86 @@
+package polynomial
+
+import "math/big"
+
+// bernoulliNumbers() returns B_0, ..., B_n with B_1 = -1/2, from sum_(k=0..j) C(j+1, k) B_k = 0
+func bernoulliNumbers(n int) []*big.Rat {
+	b := make([]*big.Rat, n+1)
+	for j := 0; j <= n; j++ {
+		if j == 0 {
+			b[0] = big.NewRat(1, 1)
+			continue
+		}
+		sum := new(big.Rat)
+		binom := big.NewInt(1)
+		for k := 0; k < j; k++ {
+			sum.Add(sum, new(big.Rat).Mul(new(big.Rat).SetInt(binom), b[k]))
+			binom.Mul(binom, big.NewInt(int64(j+1-k)))
+			binom.Quo(binom, big.NewInt(int64(k+1)))
+		}
+		b[j] = sum.Quo(sum, new(big.Rat).SetInt(binom)).Neg(sum)
+	}
+	return b
+}
+
+// bernoulliRat() returns B_n(x) = sum C(n, k) B_k x^(n-k)
+func bernoulliRat(n int) RatPoly {
+	b := bernoulliNumbers(n)
+	r := make(RatPoly, n+1)
+	binom := big.NewInt(1)
+	for k := 0; k <= n; k++ {
+		r[n-k] = new(big.Rat).Mul(new(big.Rat).SetInt(binom), b[k])
+		binom.Mul(binom, big.NewInt(int64(n-k)))
+		binom.Quo(binom, big.NewInt(int64(k+1)))
+	}
+	return r
+}
+
+// BernoulliPoly() returns the Bernoulli polynomial B_n as num / den with the smallest common denominator
+// B_n(x + 1) - B_n(x) = n x^(n-1), and B_n(0) is the Bernoulli number B_n with B_1 = -1/2
+func BernoulliPoly(n int) (num Poly, den *big.Int) {
+	if n < 0 {
+		panic("polynomial: the index must be non-negative")
+	}
+	return bernoulliRat(n).ClearDenominators()
+}
+
+// EulerPoly() returns the Euler polynomial E_n as num / den, where den is a power of 2
+// E_n(x + 1) + E_n(x) = 2x^n, so 2 E_n = 2x^n - sum_(k<n) C(n, k) E_k
+func EulerPoly(n int) (num Poly, den *big.Int) {
+	if n < 0 {
+		panic("polynomial: the index must be non-negative")
+	}
+	e := make([]RatPoly, n+1)
+	for j := 0; j <= n; j++ {
+		s := make(RatPoly, j+1)
+		for i := range s {
+			s[i] = new(big.Rat)
+		}
+		s[j].SetInt64(2)
+		binom := big.NewInt(1)
+		for k := 0; k < j; k++ {
+			s = s.Sub(e[k].Mul(RatPoly{new(big.Rat).SetInt(binom)}))
+			binom.Mul(binom, big.NewInt(int64(j-k)))
+			binom.Quo(binom, big.NewInt(int64(k+1)))
+		}
+		e[j] = s.Mul(RatPoly{big.NewRat(1, 2)})
+	}
+	return e[n].ClearDenominators()
+}
+
+// PowerSum() returns 1^k + 2^k + ... + x^k as num / den (Faulhaber's formula)
+// sum_(i=0..x-1) i^k = (B_(k+1)(x) - B_(k+1)(0)) / (k + 1), and x^k is added back
+func PowerSum(k int) (num Poly, den *big.Int) {
+	if k < 0 {
+		panic("polynomial: the exponent must be non-negative")
+	}
+	b := bernoulliRat(k + 1)
+	b[0] = new(big.Rat)
+	s := b.Mul(RatPoly{big.NewRat(1, int64(k+1))})
+	if k == 0 {
+		// 0^0 = 1 is part of the sum from 0, so only x counts
+		s = s.Sub(RatPoly{big.NewRat(1, 1)})
+	}
+	s[k].Add(s[k], big.NewRat(1, 1))
+	return s.ClearDenominators()
+}
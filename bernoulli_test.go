@@ -0,0 +1,61 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBernoulliEulerPoly(t *testing.T) {
+	cases := []struct {
+		name string
+		f    func(int) (Poly, *big.Int)
+		n    int
+		num  Poly
+		den  int64
+	}{
+		{"BernoulliPoly", BernoulliPoly, 0, NewPolyInts(1), 1},
+		{"BernoulliPoly", BernoulliPoly, 1, NewPolyInts(-1, 2), 2},
+		{"BernoulliPoly", BernoulliPoly, 2, NewPolyInts(1, -6, 6), 6},
+		{"BernoulliPoly", BernoulliPoly, 3, NewPolyInts(0, 1, -3, 2), 2},
+		{"BernoulliPoly", BernoulliPoly, 4, NewPolyInts(-1, 0, 30, -60, 30), 30},
+		{"EulerPoly", EulerPoly, 0, NewPolyInts(1), 1},
+		{"EulerPoly", EulerPoly, 1, NewPolyInts(-1, 2), 2},
+		{"EulerPoly", EulerPoly, 2, NewPolyInts(0, -1, 1), 1},
+		{"EulerPoly", EulerPoly, 3, NewPolyInts(1, 0, -6, 4), 4},
+		{"PowerSum", PowerSum, 0, NewPolyInts(0, 1), 1},
+		{"PowerSum", PowerSum, 1, NewPolyInts(0, 1, 1), 2},
+		{"PowerSum", PowerSum, 2, NewPolyInts(0, 1, 3, 2), 6},
+		{"PowerSum", PowerSum, 3, NewPolyInts(0, 0, 1, 2, 1), 4},
+	}
+	for _, c := range cases {
+		num, den := c.f(c.n)
+		if num.Compare(&c.num) != 0 || den.Int64() != c.den {
+			t.Errorf("%s(%d) = %v / %v, want %v / %d", c.name, c.n, num, den, c.num, c.den)
+		}
+	}
+
+	// B_n(x + 1) - B_n(x) = n x^(n-1) and E_n(x + 1) + E_n(x) = 2x^n, scaled by the denominators
+	for n := 1; n <= 12; n++ {
+		b, bd := BernoulliPoly(n)
+		got := b.Difference(nil)
+		want := NewPolyInts(n).Clone(n-1).Mul(Poly{bd}, nil)
+		if got.Compare(&want) != 0 {
+			t.Errorf("B_%d(x + 1) - B_%d(x) = %v / %v", n, n, got, bd)
+		}
+		e, ed := EulerPoly(n)
+		got = e.Compose(NewPolyInts(1, 1), nil).Add(e, nil)
+		want = NewPolyInts(2).Clone(n).Mul(Poly{ed}, nil)
+		if got.Compare(&want) != 0 {
+			t.Errorf("E_%d(x + 1) + E_%d(x) = %v / %v", n, n, got, ed)
+		}
+	}
+
+	num, den := PowerSum(5)
+	sum := big.NewInt(0)
+	for i := int64(1); i <= 100; i++ {
+		sum.Add(sum, new(big.Int).Exp(big.NewInt(i), big.NewInt(5), nil))
+	}
+	if y := num.Eval(big.NewInt(100), nil); new(big.Int).Quo(y, den).Cmp(sum) != 0 {
+		t.Errorf("PowerSum(5) at 100 is %v / %v, want %v", y, den, sum)
+	}
+}
@@ -0,0 +1,37 @@
+package polynomial
+
+import "math/big"
+
+// BinomialPower() returns (x + a)^n = sum C(n, k) a^(n-k) x^k
+// The binomial coefficients are updated by exact ratios over Z instead of n - 1 polynomial multiplications,
+// so no inverse is needed and any modulus works
+// modulo m can be nil
+func BinomialPower(a *big.Int, n int, m *big.Int) Poly {
+	if n < 0 {
+		panic("polynomial: the exponent must be non-negative")
+	}
+	p := make(Poly, n+1)
+	binom := big.NewInt(1)
+	apow := big.NewInt(1)
+	base := new(big.Int).Set(a)
+	if m != nil {
+		base.Mod(base, m)
+	}
+	// walk from x^n down so that a^(n-k) is built incrementally
+	for k := n; k >= 0; k-- {
+		c := new(big.Int).Mul(binom, apow)
+		if m != nil {
+			c.Mod(c, m)
+		}
+		p[k] = c
+		// C(n, k-1) = C(n, k) k / (n - k + 1)
+		binom.Mul(binom, big.NewInt(int64(k)))
+		binom.Quo(binom, big.NewInt(int64(n-k+1)))
+		apow.Mul(apow, base)
+		if m != nil {
+			apow.Mod(apow, m)
+		}
+	}
+	p.trim()
+	return p
+}
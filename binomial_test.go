@@ -0,0 +1,41 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBinomialPower(t *testing.T) {
+	cases := []struct {
+		a    int64
+		n    int
+		m    *big.Int
+		want Poly
+	}{
+		{1, 0, nil, NewPolyInts(1)},
+		{1, 4, nil, NewPolyInts(1, 4, 6, 4, 1)},
+		{-2, 3, nil, NewPolyInts(-8, 12, -6, 1)},
+		{0, 3, nil, NewPolyInts(0, 0, 0, 1)},
+		{3, 2, big.NewInt(7), NewPolyInts(2, 6, 1)},
+		// (x + 1)^4 = x^4 + 1 in characteristic 2
+		{1, 4, big.NewInt(2), NewPolyInts(1, 0, 0, 0, 1)},
+		// 6 is not prime, but the binomial coefficients never need an inverse
+		{5, 3, big.NewInt(6), NewPolyInts(5, 3, 3, 1)},
+	}
+	for _, c := range cases {
+		got := BinomialPower(big.NewInt(c.a), c.n, c.m)
+		if got.Compare(&c.want) != 0 {
+			t.Errorf("BinomialPower(%d, %d) mod %v = %v, want %v", c.a, c.n, c.m, got, c.want)
+		}
+	}
+
+	// agrees with repeated multiplication
+	m := big.NewInt(1000003)
+	want := NewPolyInts(1)
+	for i := 0; i < 40; i++ {
+		want = want.Mul(NewPolyInts(123, 1), m)
+	}
+	if got := BinomialPower(big.NewInt(123), 40, m); got.Compare(&want) != 0 {
+		t.Errorf("BinomialPower(123, 40) = %v, want %v", got, want)
+	}
+}
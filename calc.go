@@ -0,0 +1,148 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Calc chains polynomial operations and defers error handling to the end, like big.Float's
+// accuracy reporting: NewCalc(p).Mul(q).Add(r).Mod(m).Result()
+// The first error (nil coefficient, inexact division, ...) is kept and every later
+// operation becomes a no-op
+type Calc struct {
+	p   Poly
+	m   *big.Int
+	err error
+}
+
+var (
+	errDivByZero  = errors.New("polynomial: division by zero")
+	errInexactDiv = errors.New("polynomial: the division is not exact")
+	errBadModulus = errors.New("polynomial: the modulus must be positive")
+)
+
+// NewCalc() starts a chain with a copy of P and no modulus
+func NewCalc(p Poly) *Calc {
+	c := &Calc{}
+	c.p, _ = c.operand(p)
+	return c
+}
+
+// validCoeffs() checks that P has no nil coefficient
+func (p Poly) validCoeffs() bool {
+	for _, c := range p {
+		if c == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// operand() validates Q and returns a copy, or records the error
+func (c *Calc) operand(q Poly) (Poly, bool) {
+	if c.err != nil {
+		return nil, false
+	}
+	if len(q) == 0 || !q.validCoeffs() {
+		c.err = q.Validate()
+		return nil, false
+	}
+	r := q.Clone(0)
+	r.trim()
+	return r, true
+}
+
+// Mod() reduces the current value modulo m, and every later operation is done modulo m
+func (c *Calc) Mod(m *big.Int) *Calc {
+	if c.err != nil {
+		return c
+	}
+	if m == nil || m.Sign() <= 0 {
+		c.err = errBadModulus
+		return c
+	}
+	c.m = new(big.Int).Set(m)
+	c.p.sanitize(c.m)
+	return c
+}
+
+// Add() adds Q
+func (c *Calc) Add(q Poly) *Calc {
+	if r, ok := c.operand(q); ok {
+		c.p = c.p.Add(r, c.m)
+	}
+	return c
+}
+
+// Sub() subtracts Q
+func (c *Calc) Sub(q Poly) *Calc {
+	if r, ok := c.operand(q); ok {
+		c.p = c.p.Sub(r, c.m)
+	}
+	return c
+}
+
+// Mul() multiplies by Q
+func (c *Calc) Mul(q Poly) *Calc {
+	if r, ok := c.operand(q); ok {
+		c.p = c.p.Mul(r, c.m)
+	}
+	return c
+}
+
+// Div() divides by Q and fails unless the remainder is zero
+func (c *Calc) Div(q Poly) *Calc {
+	if r, ok := c.operand(q); ok {
+		if r.isZeroMod(c.m) {
+			c.err = errDivByZero
+			return c
+		}
+		quo, rem := c.p.Div(r, c.m)
+		if !rem.isZero() {
+			c.err = errInexactDiv
+			return c
+		}
+		c.p = quo
+	}
+	return c
+}
+
+// Rem() replaces the value by its remainder modulo Q
+// Over Z the leading coefficient of Q must divide the leading coefficients met during the division
+func (c *Calc) Rem(q Poly) *Calc {
+	if r, ok := c.operand(q); ok {
+		if r.isZeroMod(c.m) {
+			c.err = errDivByZero
+			return c
+		}
+		_, rem := c.p.Div(r, c.m)
+		if c.m == nil && rem.GetDegree() >= r.GetDegree() {
+			c.err = errInexactDiv
+			return c
+		}
+		c.p = rem
+	}
+	return c
+}
+
+// Monic() makes the value monic (see Poly.Monic())
+func (c *Calc) Monic() *Calc {
+	if c.err != nil {
+		return c
+	}
+	c.p, c.err = c.p.Monic(c.m)
+	return c
+}
+
+// Err() returns the first error of the chain
+func (c *Calc) Err() error {
+	return c.err
+}
+
+// Result() returns the final value and the first error of the chain (nil value on error)
+func (c *Calc) Result() (Poly, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.p.Clone(0), nil
+}
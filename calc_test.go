@@ -0,0 +1,87 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCalc(t *testing.T) {
+	m := big.NewInt(11)
+	cases := []struct {
+		c   *Calc
+		ans Poly
+		ok  bool
+	}{
+		{
+			NewCalc(NewPolyInts(1, 1)).Mul(NewPolyInts(-1, 1)).Add(NewPolyInts(1)),
+			NewPolyInts(0, 0, 1),
+			true,
+		},
+		{
+			NewCalc(NewPolyInts(1, 2)).Mul(NewPolyInts(3, 4)).Mod(m),
+			NewPolyInts(3, 10, 8),
+			true,
+		},
+		{
+			NewCalc(NewPolyInts(-1, 0, 1)).Div(NewPolyInts(-1, 1)).Sub(NewPolyInts(1)),
+			NewPolyInts(0, 1),
+			true,
+		},
+		{
+			NewCalc(NewPolyInts(1, 0, 1)).Mod(m).Div(NewPolyInts(0, 2)).Mul(NewPolyInts(2)),
+			nil,
+			false, // x^2 + 1 is not a multiple of 2x
+		},
+		{
+			NewCalc(NewPolyInts(1, 0, 2)).Mod(m).Monic(),
+			NewPolyInts(6, 0, 1),
+			true,
+		},
+		{
+			NewCalc(NewPolyInts(5, 0, 1)).Rem(NewPolyInts(1, 1)),
+			NewPolyInts(6),
+			true,
+		},
+		{
+			NewCalc(NewPolyInts(1, 2)).Add(Poly{big.NewInt(1), nil}).Mul(NewPolyInts(3)),
+			nil,
+			false, // nil coefficient
+		},
+		{
+			NewCalc(NewPolyInts(1, 2)).Div(NewPolyInts(0)),
+			nil,
+			false, // division by zero
+		},
+		{
+			NewCalc(Poly{}).Add(NewPolyInts(1)),
+			nil,
+			false, // empty polynomial
+		},
+	}
+	for i, c := range cases {
+		res, err := c.c.Result()
+		if (err == nil) != c.ok {
+			t.Errorf("case %v: unexpected error %v", i, err)
+			continue
+		}
+		if c.ok && res.Compare(&c.ans) != 0 {
+			t.Errorf("case %v: the chain gives %v, want %v", i, res, c.ans)
+		}
+		if c.c.Err() != err {
+			t.Errorf("case %v: Err() and Result() disagree", i)
+		}
+	}
+}
+
+func TestCalcDoesNotModifyOperands(t *testing.T) {
+	m := big.NewInt(11)
+	p := NewPolyInts(-1, 20)
+	q := NewPolyInts(13, -2)
+	NewCalc(p).Mod(m).Mul(q).Result()
+	if ans := NewPolyInts(-1, 20); p.Compare(&ans) != 0 {
+		t.Errorf("the starting polynomial changed to %v", p)
+	}
+	if ans := NewPolyInts(13, -2); q.Compare(&ans) != 0 {
+		t.Errorf("the operand changed to %v", q)
+	}
+}
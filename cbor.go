@@ -0,0 +1,190 @@
+package polynomial
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+var errCBOR = errors.New("polynomial: malformed or unsupported CBOR data")
+
+// CBOR major types and bignum tags (RFC 8949)
+const (
+	cborUint   = 0
+	cborNegInt = 1
+	cborBytes  = 2
+	cborArray  = 4
+	cborTag    = 6
+
+	cborPosBignum = 2
+	cborNegBignum = 3
+)
+
+// cborHead() appends the initial byte of a data item with argument n, in its shortest form
+func cborHead(b []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(b, major<<5|byte(n))
+	case n <= 0xff:
+		return append(b, major<<5|24, byte(n))
+	case n <= 0xffff:
+		return binary.BigEndian.AppendUint16(append(b, major<<5|25), uint16(n))
+	case n <= 0xffffffff:
+		return binary.BigEndian.AppendUint32(append(b, major<<5|26), uint32(n))
+	}
+	return binary.BigEndian.AppendUint64(append(b, major<<5|27), n)
+}
+
+// cborAppendInt() appends x as a CBOR integer, or as a tag 2 / tag 3 bignum when it does not fit in 64 bits
+func cborAppendInt(b []byte, x *big.Int) []byte {
+	major, tag, n := byte(cborUint), uint64(cborPosBignum), new(big.Int).Set(x)
+	if x.Sign() < 0 {
+		// a negative x is encoded as -1 - x
+		major, tag = cborNegInt, cborNegBignum
+		n.Neg(n).Sub(n, big.NewInt(1))
+	}
+	if n.IsUint64() {
+		return cborHead(b, major, n.Uint64())
+	}
+	b = cborHead(b, cborTag, tag)
+	mag := n.Bytes()
+	return append(cborHead(b, cborBytes, uint64(len(mag))), mag...)
+}
+
+// cborReadHead() splits the initial byte and argument of the next definite-length data item off b
+func cborReadHead(b []byte) (major byte, n uint64, rest []byte, err error) {
+	if len(b) == 0 {
+		return 0, 0, nil, errCBOR
+	}
+	major, info, b := b[0]>>5, b[0]&31, b[1:]
+	size := 0
+	switch {
+	case info < 24:
+		return major, uint64(info), b, nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, 0, nil, errCBOR
+	}
+	if len(b) < size {
+		return 0, 0, nil, errCBOR
+	}
+	for _, c := range b[:size] {
+		n = n<<8 | uint64(c)
+	}
+	return major, n, b[size:], nil
+}
+
+// cborReadInt() decodes an integer or a bignum
+func cborReadInt(b []byte) (*big.Int, []byte, error) {
+	major, n, b, err := cborReadHead(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	x := new(big.Int)
+	switch major {
+	case cborUint:
+		return x.SetUint64(n), b, nil
+	case cborNegInt:
+		x.SetUint64(n)
+		return x.Neg(x).Sub(x, big.NewInt(1)), b, nil
+	case cborTag:
+		if n != cborPosBignum && n != cborNegBignum {
+			return nil, nil, errCBOR
+		}
+		m, l, rest, err := cborReadHead(b)
+		if err != nil || m != cborBytes || l > uint64(len(rest)) {
+			return nil, nil, errCBOR
+		}
+		x.SetBytes(rest[:l])
+		if n == cborNegBignum {
+			x.Neg(x).Sub(x, big.NewInt(1))
+		}
+		return x, rest[l:], nil
+	}
+	return nil, nil, errCBOR
+}
+
+// cborReadArray() decodes the head of a definite-length array
+func cborReadArray(b []byte) (int, []byte, error) {
+	major, n, b, err := cborReadHead(b)
+	// every element takes at least one byte, which bounds the allocation
+	if err != nil || major != cborArray || n > uint64(len(b)) {
+		return 0, nil, errCBOR
+	}
+	return int(n), b, nil
+}
+
+// MarshalCBOR() encodes P as an array of integers, lowest degree first, with bignums (tags 2 and 3) for
+// coefficients beyond 64 bits
+func (p Poly) MarshalCBOR() ([]byte, error) {
+	b := cborHead(nil, cborArray, uint64(len(p)))
+	for _, c := range p {
+		b = cborAppendInt(b, c)
+	}
+	return b, nil
+}
+
+// UnmarshalCBOR() decodes the output of MarshalCBOR(); only definite-length items are accepted
+func (p *Poly) UnmarshalCBOR(b []byte) error {
+	n, b, err := cborReadArray(b)
+	if err != nil {
+		return err
+	}
+	q := make(Poly, n)
+	for i := range q {
+		if q[i], b, err = cborReadInt(b); err != nil {
+			return err
+		}
+	}
+	if len(b) != 0 {
+		return errCBOR
+	}
+	if n == 0 {
+		q = NewPolyInts(0)
+	}
+	q.trim()
+	*p = q
+	return nil
+}
+
+// MarshalCBOR() encodes the points as an array of [x, y] pairs
+func (ps Points) MarshalCBOR() ([]byte, error) {
+	b := cborHead(nil, cborArray, uint64(len(ps)))
+	for _, p := range ps {
+		b = cborAppendInt(cborAppendInt(cborHead(b, cborArray, 2), p.x), p.y)
+	}
+	return b, nil
+}
+
+// UnmarshalCBOR() decodes the output of MarshalCBOR()
+func (ps *Points) UnmarshalCBOR(b []byte) error {
+	n, b, err := cborReadArray(b)
+	if err != nil {
+		return err
+	}
+	qs := make(Points, n)
+	for i := range qs {
+		var l int
+		if l, b, err = cborReadArray(b); err != nil || l != 2 {
+			return errCBOR
+		}
+		if qs[i].x, b, err = cborReadInt(b); err != nil {
+			return err
+		}
+		if qs[i].y, b, err = cborReadInt(b); err != nil {
+			return err
+		}
+	}
+	if len(b) != 0 {
+		return errCBOR
+	}
+	*ps = qs
+	return nil
+}
@@ -0,0 +1,61 @@
+package polynomial
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+func TestPolyCBOR(t *testing.T) {
+	big64, _ := new(big.Int).SetString("18446744073709551616", 10) // 2^64
+	cases := []struct {
+		p    Poly
+		cbor string
+	}{
+		// examples of RFC 8949 Appendix A: 1, -1, 24, -100, 1000000
+		{NewPolyInts(1, -1, 24, -100, 1000000), "850120181838631a000f4240"},
+		{Poly{big64}, "81c249010000000000000000"},
+		{Poly{new(big.Int).Neg(big64).Sub(new(big.Int).Neg(big64), big.NewInt(1))}, "81c349010000000000000000"},
+		{Poly{new(big.Int).Neg(big64)}, "813bffffffffffffffff"},
+		{NewPolyInts(0), "8100"},
+	}
+	for _, c := range cases {
+		b, err := c.p.MarshalCBOR()
+		if err != nil || hex.EncodeToString(b) != c.cbor {
+			t.Errorf("%v.MarshalCBOR() = %x, %v, expected %s", c.p, b, err, c.cbor)
+		}
+		var p Poly
+		if err := p.UnmarshalCBOR(b); err != nil || !p.Equal(c.p) {
+			t.Errorf("UnmarshalCBOR(%x) = %v, %v, expected %v", b, p, err, c.p)
+		}
+	}
+	// a non-preferred encoding still decodes
+	var p Poly
+	if err := p.UnmarshalCBOR([]byte{0x82, 0x19, 0x00, 0x01, 0xc2, 0x41, 0x05}); err != nil || !p.Equal(NewPolyInts(1, 5)) {
+		t.Errorf("UnmarshalCBOR() of long forms = %v, %v", p, err)
+	}
+	for _, s := range []string{"", "9f01ff", "8201", "810100", "81c44101", "81c2", "81c24301", "a0", "9b00000000ffffffff", "8160"} {
+		b, _ := hex.DecodeString(s)
+		if err := p.UnmarshalCBOR(b); err != errCBOR {
+			t.Errorf("UnmarshalCBOR(%s) error = %v", s, err)
+		}
+	}
+}
+
+func TestPointsCBOR(t *testing.T) {
+	ps := Points{NewPoint(big.NewInt(1), big.NewInt(-2)), NewPoint(big.NewInt(3), new(big.Int).Lsh(big.NewInt(1), 100))}
+	b, err := ps.MarshalCBOR()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(b[:4]) != "82820121" {
+		t.Errorf("MarshalCBOR() = %x", b)
+	}
+	var qs Points
+	if err := qs.UnmarshalCBOR(b); err != nil || qs.String() != ps.String() {
+		t.Errorf("UnmarshalCBOR() = %v, %v, expected %v", qs, err, ps)
+	}
+	if err := qs.UnmarshalCBOR([]byte{0x81, 0x81, 0x01}); err != errCBOR {
+		t.Errorf("UnmarshalCBOR() of a 1-element point error = %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package polynomial
+
+import "math/big"
+
+// copyMatrix() returns a deep copy of a reduced modulo m (m can be nil)
+func copyMatrix(a [][]*big.Int, m *big.Int) [][]*big.Int {
+	r := make([][]*big.Int, len(a))
+	for i, row := range a {
+		r[i] = make([]*big.Int, len(row))
+		for j, c := range row {
+			r[i][j] = new(big.Int).Set(c)
+			if m != nil {
+				r[i][j].Mod(r[i][j], m)
+			}
+		}
+	}
+	return r
+}
+
+// CharPoly() returns det(xI - A) for a square matrix A
+// Modulo a prime m, A is reduced to upper Hessenberg form by similarity transforms in O(n^3) and the
+// determinant is expanded along the last column recursively; without a modulus the Faddeev-LeVerrier
+// recurrence is used, whose divisions by k are exact over Z
+// It panics if A is not square
+func CharPoly(a [][]*big.Int, m *big.Int) Poly {
+	if !isSquare(a) {
+		panic("polynomial: CharPoly needs a square matrix")
+	}
+	if m == nil {
+		return faddeevLeVerrier(a)
+	}
+	n := len(a)
+	h := copyMatrix(a, m)
+	t := new(big.Int)
+	for j := 0; j+2 < n; j++ {
+		piv := -1
+		for i := j + 1; i < n; i++ {
+			if h[i][j].Sign() != 0 {
+				piv = i
+				break
+			}
+		}
+		if piv < 0 {
+			continue
+		}
+		if piv != j+1 {
+			h[piv], h[j+1] = h[j+1], h[piv]
+			for _, row := range h {
+				row[piv], row[j+1] = row[j+1], row[piv]
+			}
+		}
+		inv := new(big.Int).ModInverse(h[j+1][j], m)
+		for i := j + 2; i < n; i++ {
+			if h[i][j].Sign() == 0 {
+				continue
+			}
+			u := new(big.Int).Mul(h[i][j], inv)
+			u.Mod(u, m)
+			// row_i -= u row_(j+1), then col_(j+1) += u col_i keeps the matrix similar
+			for k := range h[i] {
+				h[i][k].Sub(h[i][k], t.Mul(u, h[j+1][k])).Mod(h[i][k], m)
+			}
+			for k := range h {
+				h[k][j+1].Add(h[k][j+1], t.Mul(u, h[k][i])).Mod(h[k][j+1], m)
+			}
+		}
+	}
+	// p_k = (x - h_(k-1,k-1)) p_(k-1) - sum_i h_(k-1-i,k-1) h_(k-1,k-2)...h_(k-i,k-i-1) p_(k-1-i)
+	ps := make([]Poly, n+1)
+	ps[0] = NewPolyInts(1)
+	for k := 1; k <= n; k++ {
+		pk := ps[k-1].Mul(xMinusConst(h[k-1][k-1]), m)
+		prod := big.NewInt(1)
+		for i := 1; i < k; i++ {
+			prod.Mul(prod, h[k-i][k-i-1]).Mod(prod, m)
+			c := new(big.Int).Mul(h[k-1-i][k-1], prod)
+			pk = pk.Sub(ps[k-1-i].Mul(Poly{c.Mod(c, m)}, m), m)
+		}
+		ps[k] = pk
+	}
+	return ps[n]
+}
+
+// faddeevLeVerrier() returns det(xI - A) over Z
+// M_1 = I, c_(n-k) = -tr(A M_k) / k and M_(k+1) = A M_k + c_(n-k) I
+func faddeevLeVerrier(a [][]*big.Int) Poly {
+	n := len(a)
+	c := make(Poly, n+1)
+	for i := range c {
+		c[i] = new(big.Int)
+	}
+	c[n].SetInt64(1)
+	mk := identityMatrix(n)
+	for k := 1; k <= n; k++ {
+		am := matMul(a, mk, nil)
+		tr := new(big.Int)
+		for i := range am {
+			tr.Add(tr, am[i][i])
+		}
+		c[n-k].Quo(tr.Neg(tr), big.NewInt(int64(k)))
+		for i := range am {
+			am[i][i].Add(am[i][i], c[n-k])
+		}
+		mk = am
+	}
+	return c
+}
@@ -0,0 +1,66 @@
+package polynomial
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func intMatrix(rows ...[]int64) [][]*big.Int {
+	a := make([][]*big.Int, len(rows))
+	for i, row := range rows {
+		a[i] = make([]*big.Int, len(row))
+		for j, c := range row {
+			a[i][j] = big.NewInt(c)
+		}
+	}
+	return a
+}
+
+func TestCharPoly(t *testing.T) {
+	cases := []struct {
+		a    [][]*big.Int
+		m    *big.Int
+		want Poly
+	}{
+		{intMatrix(), nil, NewPolyInts(1)},
+		{intMatrix([]int64{5}), nil, NewPolyInts(-5, 1)},
+		// x^2 - 5x - 2
+		{intMatrix([]int64{1, 2}, []int64{3, 4}), nil, NewPolyInts(-2, -5, 1)},
+		{intMatrix([]int64{1, 2}, []int64{3, 4}), big.NewInt(7), NewPolyInts(5, 2, 1)},
+		// a zero first column below the diagonal needs a pivot search
+		{intMatrix([]int64{2, 1, 0}, []int64{0, 3, 1}, []int64{1, 0, 4}), nil, NewPolyInts(-25, 26, -9, 1)},
+		{intMatrix([]int64{2, 1, 0}, []int64{0, 3, 1}, []int64{1, 0, 4}), big.NewInt(11), NewPolyInts(8, 4, 2, 1)},
+	}
+	for _, c := range cases {
+		if got := CharPoly(c.a, c.m); !got.Equal(c.want) {
+			t.Errorf("CharPoly(%v) mod %v = %v, expected %v", c.a, c.m, got, c.want)
+		}
+	}
+
+	// the companion matrix of P has characteristic polynomial P
+	m := big.NewInt(998244353)
+	p := NewPolyInts(4, 0, -7, 3, 0, 1)
+	comp, _ := p.CompanionMatrix(m)
+	if got := CharPoly(comp, m); !got.Equal(p.Clone(0).Add(NewPolyInts(0), m)) {
+		t.Errorf("CharPoly(companion of %v) = %v", p, got)
+	}
+
+	// the two methods agree on random integer matrices
+	rr := rand.New(rand.NewSource(3))
+	for n := 1; n <= 7; n++ {
+		a := make([][]*big.Int, n)
+		for i := range a {
+			a[i] = make([]*big.Int, n)
+			for j := range a[i] {
+				a[i][j] = big.NewInt(rr.Int63n(21) - 10)
+			}
+		}
+		want := CharPoly(a, nil)
+		want.sanitize(m)
+		want.trim()
+		if got := CharPoly(a, m); !got.Equal(want) {
+			t.Errorf("CharPoly() of a %dx%d matrix: %v mod m, %v over Z", n, n, got, want)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package polynomial
+
+import "math/big"
+
+// recur() returns P_n of a sequence given P_0, P_1 and P_(k+1) = next(k, P_(k-1), P_k)
+func recur(n int, p0, p1 Poly, next func(k int, prev, cur Poly) Poly) Poly {
+	if n < 0 {
+		panic("polynomial: the index must be non-negative")
+	}
+	if n == 0 {
+		return p0
+	}
+	prev, cur := p0, p1
+	for k := 1; k < n; k++ {
+		prev, cur = cur, next(k, prev, cur)
+	}
+	return cur
+}
+
+// ChebyshevT() returns the Chebyshev polynomial of the first kind, T_n(cos t) = cos(nt)
+// T_0 = 1, T_1 = x, T_(k+1) = 2x T_k - T_(k-1)
+func ChebyshevT(n int) Poly {
+	return recur(n, NewPolyInts(1), NewPolyInts(0, 1), func(_ int, prev, cur Poly) Poly {
+		return cur.Clone(1).Mul(NewPolyInts(2), nil).Sub(prev, nil)
+	})
+}
+
+// ChebyshevU() returns the Chebyshev polynomial of the second kind, U_n(cos t) sin t = sin((n + 1)t)
+// U_0 = 1, U_1 = 2x, U_(k+1) = 2x U_k - U_(k-1)
+func ChebyshevU(n int) Poly {
+	return recur(n, NewPolyInts(1), NewPolyInts(0, 2), func(_ int, prev, cur Poly) Poly {
+		return cur.Clone(1).Mul(NewPolyInts(2), nil).Sub(prev, nil)
+	})
+}
+
+// Dickson() returns the Dickson polynomial D_n(x, a), with D_n(y + a/y, a) = y^n + (a/y)^n
+// D_0 = 2, D_1 = x, D_(k+1) = x D_k - a D_(k-1); D_n(x, 1) is 2 T_n(x/2)
+// Modulo a prime m, D_n(x, a) with a != 0 permutes GF(m) iff gcd(n, m^2 - 1) = 1
+// modulo m can be nil
+func Dickson(n int, a, m *big.Int) Poly {
+	p0, p1 := NewPolyInts(2), NewPolyInts(0, 1)
+	p0.sanitize(m)
+	return recur(n, p0, p1, func(_ int, prev, cur Poly) Poly {
+		return cur.Clone(1).Sub(prev.Clone(0).Mul(Poly{new(big.Int).Set(a)}, m), m)
+	})
+}
@@ -0,0 +1,60 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestChebyshev(t *testing.T) {
+	cases := []struct {
+		n    int
+		t, u Poly
+	}{
+		{0, NewPolyInts(1), NewPolyInts(1)},
+		{1, NewPolyInts(0, 1), NewPolyInts(0, 2)},
+		{2, NewPolyInts(-1, 0, 2), NewPolyInts(-1, 0, 4)},
+		{3, NewPolyInts(0, -3, 0, 4), NewPolyInts(0, -4, 0, 8)},
+		{5, NewPolyInts(0, 5, 0, -20, 0, 16), NewPolyInts(0, 6, 0, -32, 0, 32)},
+	}
+	for _, c := range cases {
+		if got := ChebyshevT(c.n); got.Compare(&c.t) != 0 {
+			t.Errorf("ChebyshevT(%d) = %v, want %v", c.n, got, c.t)
+		}
+		if got := ChebyshevU(c.n); got.Compare(&c.u) != 0 {
+			t.Errorf("ChebyshevU(%d) = %v, want %v", c.n, got, c.u)
+		}
+	}
+
+	// T_m o T_n = T_mn
+	got := ChebyshevT(3).Compose(ChebyshevT(4), nil)
+	if want := ChebyshevT(12); got.Compare(&want) != 0 {
+		t.Errorf("T_3(T_4) = %v, want %v", got, want)
+	}
+}
+
+func TestDickson(t *testing.T) {
+	// D_4(x, a) = x^4 - 4ax^2 + 2a^2
+	got := Dickson(4, big.NewInt(3), nil)
+	if want := NewPolyInts(18, 0, -12, 0, 1); got.Compare(&want) != 0 {
+		t.Errorf("Dickson(4, 3) = %v, want %v", got, want)
+	}
+	// gcd(5, 7^2 - 1) = 1, so D_5(x, 2) permutes GF(7), while D_3 does not
+	m := big.NewInt(7)
+	cases := []struct {
+		n    int
+		perm bool
+	}{
+		{5, true},
+		{3, false},
+	}
+	for _, c := range cases {
+		d := Dickson(c.n, big.NewInt(2), m)
+		seen := make(map[int64]bool)
+		for x := int64(0); x < 7; x++ {
+			seen[d.Eval(big.NewInt(x), m).Int64()] = true
+		}
+		if perm := len(seen) == 7; perm != c.perm {
+			t.Errorf("Dickson(%d, 2) mod 7 permutes GF(7): %v, want %v", c.n, perm, c.perm)
+		}
+	}
+}
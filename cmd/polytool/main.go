@@ -0,0 +1,222 @@
+// Command polytool does polynomial arithmetic and Shamir secret sharing from the command line
+//
+// Polynomials are read with ParsePoly() and printed with MarshalText() ("3x^2 - x + 7"), so results can be fed
+// back in; shares are the share codes of EncodeShare(), one per line
+//
+//	polytool parse [-m M] P            prints P normalized
+//	polytool add|mul|gcd [-m M] P Q    prints P + Q, P * Q or gcd(P, Q)
+//	polytool div [-m M] P Q            prints the quotient and the remainder on two lines
+//	polytool eval [-m M] P X           prints P(X)
+//	polytool share -m Q -n N -k K S    prints N share codes of the secret S, any K of which recover it
+//	polytool recover -m Q              reads share codes from the standard input and prints the secret
+//
+// The modulus M can be omitted for arithmetic over Z (the gcd then has a positive leading coefficient and
+// includes the content); use "--" before arguments starting with "-"
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/jongukim/polynomial"
+)
+
+var errUsage = errors.New("usage: polytool parse|add|mul|div|gcd|eval|share|recover [flags] args")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "polytool:", err)
+		os.Exit(2)
+	}
+}
+
+// parseInt() reads a decimal (or 0x-prefixed) integer
+func parseInt(s string) (*big.Int, error) {
+	x, ok := new(big.Int).SetString(strings.TrimSpace(s), 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+	return x, nil
+}
+
+// formatPoly() writes P the way ParsePoly() reads it
+func formatPoly(p polynomial.Poly) string {
+	b, _ := p.MarshalText()
+	return string(b)
+}
+
+// gcdZ() returns gcd(P, Q) over Z; Poly.Gcd() needs exact divisions, which only a prime modulus guarantees
+func gcdZ(p, q polynomial.Poly) polynomial.Poly {
+	g := polynomial.FromUnivariate(p, 1, 0).Gcd(polynomial.FromUnivariate(q, 1, 0), nil)
+	if g.IsZero() {
+		return polynomial.NewPolyInts(0)
+	}
+	return g.ToUnivariate(0, nil, nil)
+}
+
+// reduce() maps P into [0, m) when a modulus is given
+func reduce(p polynomial.Poly, m *big.Int) polynomial.Poly {
+	if m == nil {
+		return p
+	}
+	return p.Add(polynomial.NewPolyInts(0), m)
+}
+
+// modulus is a flag.Value for an optional big integer
+type modulus struct {
+	m *big.Int
+}
+
+func (f *modulus) String() string {
+	if f.m == nil {
+		return ""
+	}
+	return f.m.String()
+}
+
+func (f *modulus) Set(s string) error {
+	m, err := parseInt(s)
+	if err == nil && m.Sign() <= 0 {
+		err = errors.New("the modulus must be positive")
+	}
+	f.m = m
+	return err
+}
+
+// run() executes one subcommand
+func run(args []string, in io.Reader, out io.Writer) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var m modulus
+	fs.Var(&m, "m", "modulus")
+	n := fs.Int("n", 0, "number of shares")
+	k := fs.Int("k", 0, "threshold")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	rest := fs.Args()
+	switch args[0] {
+	case "parse":
+		if len(rest) != 1 {
+			return errUsage
+		}
+		p, err := polynomial.ParsePoly(rest[0])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, formatPoly(reduce(p, m.m)))
+	case "add", "mul", "div", "gcd":
+		if len(rest) != 2 {
+			return errUsage
+		}
+		p, err := polynomial.ParsePoly(rest[0])
+		if err != nil {
+			return err
+		}
+		q, err := polynomial.ParsePoly(rest[1])
+		if err != nil {
+			return err
+		}
+		switch args[0] {
+		case "add":
+			fmt.Fprintln(out, formatPoly(p.Add(q, m.m)))
+		case "mul":
+			fmt.Fprintln(out, formatPoly(reduce(p.Mul(q, m.m), m.m)))
+		case "div":
+			if q.Equal(polynomial.NewPolyInts(0)) {
+				return errors.New("division by zero")
+			}
+			quo, rem := p.Div(q, m.m)
+			fmt.Fprintln(out, formatPoly(reduce(quo, m.m)))
+			fmt.Fprintln(out, formatPoly(reduce(rem, m.m)))
+		case "gcd":
+			if m.m == nil {
+				fmt.Fprintln(out, formatPoly(gcdZ(p, q)))
+			} else {
+				fmt.Fprintln(out, formatPoly(reduce(p.Gcd(q, m.m), m.m)))
+			}
+		}
+	case "eval":
+		if len(rest) != 2 {
+			return errUsage
+		}
+		p, err := polynomial.ParsePoly(rest[0])
+		if err != nil {
+			return err
+		}
+		x, err := parseInt(rest[1])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, p.Eval(x, m.m))
+	case "share":
+		if len(rest) != 1 || m.m == nil || *k < 1 || *n < *k {
+			return errUsage
+		}
+		if !m.m.ProbablyPrime(20) || m.m.Cmp(big.NewInt(int64(*n))) <= 0 {
+			return errors.New("the modulus must be a prime above the number of shares")
+		}
+		s, err := parseInt(rest[0])
+		if err != nil {
+			return err
+		}
+		p := make(polynomial.Poly, *k)
+		p[0] = s.Mod(s, m.m)
+		for i := 1; i < *k; i++ {
+			p[i], _ = rand.Int(rand.Reader, m.m)
+		}
+		for i := 1; i <= *n; i++ {
+			x := big.NewInt(int64(i))
+			fmt.Fprintln(out, polynomial.EncodeShare(polynomial.NewPoint(x, p.Eval(x, m.m)), *k, m.m))
+		}
+	case "recover":
+		if len(rest) != 0 || m.m == nil {
+			return errUsage
+		}
+		var ps polynomial.Points
+		threshold := 0
+		seen := map[string]bool{}
+		sc := bufio.NewScanner(in)
+		for sc.Scan() {
+			if strings.TrimSpace(sc.Text()) == "" {
+				continue
+			}
+			s, k, err := polynomial.DecodeShare(sc.Text(), m.m)
+			if err != nil {
+				return fmt.Errorf("share %q: %v", sc.Text(), err)
+			}
+			if threshold != 0 && k != threshold {
+				return errors.New("the shares come from sharings with different thresholds")
+			}
+			threshold = k
+			if x := s.X(); seen[x.String()] {
+				return fmt.Errorf("duplicate share at x = %v", x)
+			}
+			seen[s.X().String()] = true
+			ps = append(ps, s)
+		}
+		if err := sc.Err(); err != nil {
+			return err
+		}
+		if len(ps) == 0 {
+			return errors.New("no shares")
+		}
+		if len(ps) < threshold {
+			return fmt.Errorf("%d shares, %d needed", len(ps), threshold)
+		}
+		fmt.Fprintln(out, ps.Lagrange(m.m).Eval(big.NewInt(0), m.m))
+	default:
+		return errUsage
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/jongukim/polynomial"
+)
+
+// shareCodes() encodes the shares (x, ys[x-1]) of a 2-out-of-n sharing modulo 13, one per line
+func shareCodes(ys ...int64) string {
+	var b strings.Builder
+	for i, y := range ys {
+		b.WriteString(polynomial.EncodeShare(polynomial.NewPoint(big.NewInt(int64(i+1)), big.NewInt(y)), 2, big.NewInt(13)))
+		b.WriteString("\n\n")
+	}
+	return b.String()
+}
+
+func TestRun(t *testing.T) {
+	cases := []struct {
+		args []string
+		in   string
+		want string
+	}{
+		{[]string{"parse", "1 + 3x^2 + 0x^3"}, "", "3x^2 + 1\n"},
+		{[]string{"parse", "-m", "5", "--", "-1 + 7x"}, "", "2x + 4\n"},
+		{[]string{"add", "2x + 1", "5x^2 + 4x + 3"}, "", "5x^2 + 6x + 4\n"},
+		{[]string{"mul", "-m", "7", "x + 1", "6x + 1"}, "", "6x^2 + 1\n"},
+		{[]string{"div", "-m", "7", "x^2", "x + 1"}, "", "x + 6\n1\n"},
+		{[]string{"gcd", "-m", "7", "x^2 + 6", "x + 1"}, "", "x + 1\n"},
+		// over Z the remainders are not exact, and the gcd keeps the content
+		{[]string{"gcd", "x^2 + 1", "2x"}, "", "1\n"},
+		{[]string{"gcd", "2x^2 - 2", "4x + 4"}, "", "2x + 2\n"},
+		{[]string{"eval", "3x^2 + 2x + 1", "2"}, "", "17\n"},
+		{[]string{"recover", "-m", "13"}, shareCodes(5, 7, 9), "3\n"},
+	}
+	for _, c := range cases {
+		var out bytes.Buffer
+		if err := run(c.args, strings.NewReader(c.in), &out); err != nil {
+			t.Errorf("run(%v): %v", c.args, err)
+		} else if out.String() != c.want {
+			t.Errorf("run(%v) = %q, expected %q", c.args, out.String(), c.want)
+		}
+	}
+}
+
+func TestShareRecover(t *testing.T) {
+	var shares bytes.Buffer
+	if err := run([]string{"share", "-m", "2147483647", "-n", "5", "-k", "3", "123456"}, nil, &shares); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(shares.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("share printed %d lines", len(lines))
+	}
+	var out bytes.Buffer
+	if err := run([]string{"recover", "-m", "2147483647"}, strings.NewReader(strings.Join(lines[1:4], "\n")), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "123456\n" {
+		t.Errorf("recovered %q, expected 123456", out.String())
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	cases := [][]string{
+		{},
+		{"frobnicate"},
+		{"add", "2x + 1"},
+		{"parse", "1 + y"},
+		{"div", "2x + 1", "0"},
+		{"share", "-m", "15", "-n", "3", "-k", "2", "4"},
+		{"share", "-m", "13", "-n", "2", "-k", "3", "4"},
+		{"eval", "-m", "0", "x", "2"},
+	}
+	for _, args := range cases {
+		if err := run(args, strings.NewReader(""), &bytes.Buffer{}); err == nil {
+			t.Errorf("run(%v) succeeded", args)
+		}
+	}
+	if err := run([]string{"recover", "-m", "13"}, strings.NewReader(shareCodes(2)+shareCodes(3)), &bytes.Buffer{}); err == nil {
+		t.Errorf("recover accepted duplicate shares")
+	}
+	if err := run([]string{"recover", "-m", "13"}, strings.NewReader(shareCodes(2)), &bytes.Buffer{}); err == nil {
+		t.Errorf("recover accepted fewer shares than the threshold")
+	}
+}
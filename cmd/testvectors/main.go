@@ -0,0 +1,25 @@
+// Command testvectors prints the JSON test vector suite of package testvectors for a seed
+//
+//	testvectors [-seed S] [-n N]
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jongukim/polynomial/testvectors"
+)
+
+func main() {
+	seed := flag.String("seed", "polynomial test vectors", "seed string")
+	n := flag.Int("n", 4, "cases per operation and modulus")
+	flag.Parse()
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(testvectors.Generate([]byte(*seed), *n)); err != nil {
+		fmt.Fprintln(os.Stderr, "testvectors:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,122 @@
+// Package commitments implements polynomial commitment schemes on top of
+// the polynomial package
+package commitments
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/jongukim/polynomial"
+)
+
+// G1Point, G2Point and GTPoint are opaque group elements
+// Their concrete types are decided by the Backend (e.g. bn254 or bls12-381 bindings)
+type G1Point interface{}
+type G2Point interface{}
+type GTPoint interface{}
+
+// Backend abstracts a pairing-friendly group of prime order
+// All scalars are given as big integers modulo Order()
+type Backend interface {
+	Order() *big.Int
+	G1Generator() G1Point
+	G2Generator() G2Point
+	G1Add(a, b G1Point) G1Point
+	G1ScalarMul(a G1Point, k *big.Int) G1Point
+	G2Add(a, b G2Point) G2Point
+	G2ScalarMul(a G2Point, k *big.Int) G2Point
+	Pair(a G1Point, b G2Point) GTPoint
+	GTEqual(a, b GTPoint) bool
+}
+
+var ErrDegreeTooLarge = errors.New("commitments: polynomial degree exceeds the SRS")
+
+// SRS is the structured reference string of KZG
+// G1 holds [tau^i]G1 for i = 0..degree, G2 holds [1]G2 and [tau]G2
+type SRS struct {
+	b  Backend
+	G1 []G1Point
+	G2 [2]G2Point
+}
+
+// Setup() generates an SRS for polynomials up to the given degree
+// tau is the trapdoor and MUST be discarded after the setup
+// This is only meant for tests and single-party setups
+func Setup(b Backend, tau *big.Int, degree int) *SRS {
+	r := b.Order()
+	srs := &SRS{b: b, G1: make([]G1Point, degree+1)}
+	acc := big.NewInt(1)
+	g1 := b.G1Generator()
+	for i := 0; i <= degree; i++ {
+		srs.G1[i] = b.G1ScalarMul(g1, acc)
+		acc = new(big.Int).Mul(acc, tau)
+		acc.Mod(acc, r)
+	}
+	g2 := b.G2Generator()
+	srs.G2[0] = g2
+	srs.G2[1] = b.G2ScalarMul(g2, new(big.Int).Mod(tau, r))
+	return srs
+}
+
+// NewSRS() wraps the points of an existing (e.g. ceremony generated) SRS
+func NewSRS(b Backend, g1 []G1Point, g2 [2]G2Point) *SRS {
+	return &SRS{b: b, G1: g1, G2: g2}
+}
+
+// MaxDegree() returns the highest degree the SRS can commit to
+func (srs *SRS) MaxDegree() int {
+	return len(srs.G1) - 1
+}
+
+// msm computes sum(p[i] * G1[i]), the multi-scalar multiplication over the coefficients
+func (srs *SRS) msm(p polynomial.Poly) (G1Point, error) {
+	if p.GetDegree() > srs.MaxDegree() {
+		return nil, ErrDegreeTooLarge
+	}
+	r := srs.b.Order()
+	acc := srs.b.G1ScalarMul(srs.G1[0], big.NewInt(0))
+	k := new(big.Int)
+	for i := 0; i <= p.GetDegree(); i++ {
+		k.Mod(p[i], r)
+		if k.Sign() == 0 {
+			continue
+		}
+		acc = srs.b.G1Add(acc, srs.b.G1ScalarMul(srs.G1[i], k))
+	}
+	return acc, nil
+}
+
+// Commit() returns the commitment C = [p(tau)]G1
+func (srs *SRS) Commit(p polynomial.Poly) (G1Point, error) {
+	return srs.msm(p)
+}
+
+// Open() evaluates p at z and returns y = p(z) with the proof [q(tau)]G1
+// where q(x) = (p(x) - p(z)) / (x - z)
+// Like Commit(), it fails with ErrDegreeTooLarge if p is beyond the SRS, although q would still fit
+func (srs *SRS) Open(p polynomial.Poly, z *big.Int) (y *big.Int, proof G1Point, err error) {
+	if p.GetDegree() > srs.MaxDegree() {
+		return nil, nil, ErrDegreeTooLarge
+	}
+	r := srs.b.Order()
+	y = p.Eval(z, r)
+	num := p.Sub(polynomial.Poly{y}, r)
+	den := polynomial.Poly{new(big.Int).Mod(new(big.Int).Neg(z), r), big.NewInt(1)}
+	quo, _ := num.Div(den, r)
+	proof, err = srs.msm(quo)
+	if err != nil {
+		return nil, nil, err
+	}
+	return y, proof, nil
+}
+
+// Verify() checks e(C - [y]G1, [1]G2) == e(proof, [tau]G2 - [z]G2)
+func (srs *SRS) Verify(c G1Point, z, y *big.Int, proof G1Point) bool {
+	b := srs.b
+	r := b.Order()
+	negY := new(big.Int).Mod(new(big.Int).Neg(y), r)
+	negZ := new(big.Int).Mod(new(big.Int).Neg(z), r)
+	lhs := b.G1Add(c, b.G1ScalarMul(srs.G1[0], negY))
+	rhs := b.G2Add(srs.G2[1], b.G2ScalarMul(srs.G2[0], negZ))
+	return b.GTEqual(b.Pair(lhs, srs.G2[0]), b.Pair(proof, rhs))
+}
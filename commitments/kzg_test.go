@@ -0,0 +1,77 @@
+package commitments
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/jongukim/polynomial"
+)
+
+// toyBackend represents every group as Z_r under addition and pairs by multiplication
+// It is bilinear (so KZG works) but obviously insecure
+type toyBackend struct {
+	r *big.Int
+}
+
+func (t toyBackend) Order() *big.Int            { return t.r }
+func (t toyBackend) G1Generator() G1Point       { return big.NewInt(1) }
+func (t toyBackend) G2Generator() G2Point       { return big.NewInt(1) }
+func (t toyBackend) G1Add(a, b G1Point) G1Point { return t.add(a, b) }
+func (t toyBackend) G2Add(a, b G2Point) G2Point { return t.add(a, b) }
+func (t toyBackend) G1ScalarMul(a G1Point, k *big.Int) G1Point {
+	return t.add(new(big.Int).Mul(a.(*big.Int), k), big.NewInt(0))
+}
+func (t toyBackend) G2ScalarMul(a G2Point, k *big.Int) G2Point {
+	return t.add(new(big.Int).Mul(a.(*big.Int), k), big.NewInt(0))
+}
+func (t toyBackend) Pair(a G1Point, b G2Point) GTPoint {
+	return t.add(new(big.Int).Mul(a.(*big.Int), b.(*big.Int)), big.NewInt(0))
+}
+func (t toyBackend) GTEqual(a, b GTPoint) bool {
+	return a.(*big.Int).Cmp(b.(*big.Int)) == 0
+}
+func (t toyBackend) add(a, b interface{}) *big.Int {
+	c := new(big.Int).Add(a.(*big.Int), b.(*big.Int))
+	return c.Mod(c, t.r)
+}
+
+func TestKZG(t *testing.T) {
+	b := toyBackend{big.NewInt(179424691)}
+	srs := Setup(b, big.NewInt(123456789), 8)
+	cases := []struct {
+		p polynomial.Poly
+		z *big.Int
+	}{
+		{polynomial.NewPolyInts(5), big.NewInt(3)},
+		{polynomial.NewPolyInts(1, 2, 3), big.NewInt(10)},
+		{polynomial.NewPolyInts(43, 53, 45, 63, 43, 55, 75), big.NewInt(-7)},
+		{polynomial.NewPolyInts(1, 0, 0, 0, 0, 0, 0, 0, 1), big.NewInt(179424690)},
+	}
+	for _, c := range cases {
+		com, err := srs.Commit(c.p)
+		if err != nil {
+			t.Fatalf("Commit(%v) failed: %v", c.p, err)
+		}
+		y, proof, err := srs.Open(c.p, c.z)
+		if err != nil {
+			t.Fatalf("Open(%v, %v) failed: %v", c.p, c.z, err)
+		}
+		if y.Cmp(c.p.Eval(c.z, b.r)) != 0 {
+			t.Errorf("Open(%v, %v) returns y = %v", c.p, c.z, y)
+		}
+		if !srs.Verify(com, c.z, y, proof) {
+			t.Errorf("Verify() rejects a valid opening of %v at %v", c.p, c.z)
+		}
+		wrong := new(big.Int).Add(y, big.NewInt(1))
+		if srs.Verify(com, c.z, wrong, proof) {
+			t.Errorf("Verify() accepts a wrong value %v for %v at %v", wrong, c.p, c.z)
+		}
+	}
+
+	if _, err := srs.Commit(polynomial.NewPolyInts(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)); err != ErrDegreeTooLarge {
+		t.Errorf("Commit() over the SRS degree should fail with ErrDegreeTooLarge (got %v)", err)
+	}
+	if _, _, err := srs.Open(polynomial.NewPolyInts(1, 2, 3, 4, 5, 6, 7, 8, 9, 10), big.NewInt(3)); err != ErrDegreeTooLarge {
+		t.Errorf("Open() over the SRS degree should fail with ErrDegreeTooLarge (got %v)", err)
+	}
+}
@@ -0,0 +1,33 @@
+package polynomial
+
+import "math/big"
+
+// CompanionMatrix() returns the n x n companion matrix C of P = a_0 + a_1 x + ... + a_n x^n made monic modulo m:
+// ones below the diagonal and -a_0/a_n, ..., -a_(n-1)/a_n in the last column, so det(xI - C) = P / a_n
+// C is the matrix of multiplication by x on the basis 1, x, ..., x^(n-1) of the residues mod P
+// Without a modulus a_n must be 1 or -1; constant polynomials have no companion matrix
+func (p Poly) CompanionMatrix(m *big.Int) ([][]*big.Int, error) {
+	q, err := p.Monic(m)
+	if err != nil {
+		return nil, err
+	}
+	n := q.GetDegree()
+	if n < 1 {
+		return nil, errMatrixShape
+	}
+	c := make([][]*big.Int, n)
+	for i := range c {
+		c[i] = make([]*big.Int, n)
+		for j := range c[i] {
+			c[i][j] = new(big.Int)
+		}
+		if i > 0 {
+			c[i][i-1].SetInt64(1)
+		}
+		c[i][n-1].Neg(q[i])
+		if m != nil {
+			c[i][n-1].Mod(c[i][n-1], m)
+		}
+	}
+	return c, nil
+}
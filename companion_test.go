@@ -0,0 +1,66 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCompanionMatrix(t *testing.T) {
+	// x^2 - x - 1: C = [[0, 1], [1, 1]] generates the Fibonacci numbers
+	c, err := NewPolyInts(-1, -1, 1).CompanionMatrix(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]int64{{0, 1}, {1, 1}}
+	for i := range want {
+		for j := range want[i] {
+			if c[i][j].Int64() != want[i][j] {
+				t.Fatalf("CompanionMatrix() = %v, expected %v", c, want)
+			}
+		}
+	}
+	f, err := MatrixPower(c, big.NewInt(90), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// C^n = [[F(n-1), F(n)], [F(n), F(n+1)]]
+	if f[0][1].String() != "2880067194370816120" {
+		t.Errorf("F(90) = %v", f[0][1])
+	}
+	if f, _ := MatrixPower(c, big.NewInt(0), nil); f[0][0].Int64() != 1 || f[0][1].Sign() != 0 {
+		t.Errorf("C^0 = %v, expected the identity", f)
+	}
+
+	// 2x^3 + 3x + 1 mod 7 is made monic: x^3 + 5x + 4
+	c, err = NewPolyInts(1, 3, 0, 2).CompanionMatrix(big.NewInt(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c[0][2].Int64() != 3 || c[1][2].Int64() != 2 || c[2][2].Int64() != 0 || c[1][0].Int64() != 1 {
+		t.Errorf("CompanionMatrix() mod 7 = %v", c)
+	}
+	// C acts as multiplication by x modulo P: C^k e_0 holds the coefficients of x^k mod P
+	m := big.NewInt(7)
+	p := NewPolyInts(1, 3, 0, 2)
+	ck, _ := MatrixPower(c, big.NewInt(10), m)
+	_, r := NewPolyInts(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1).Div(p.Clone(0), m)
+	for i := 0; i < 3; i++ {
+		if ck[i][0].Cmp(new(big.Int).Mod(r.Coeff(i), m)) != 0 {
+			t.Errorf("C^10 e_0 = %v, expected x^10 mod P = %v", ck, r)
+			break
+		}
+	}
+
+	if _, err := NewPolyInts(5).CompanionMatrix(m); err != errMatrixShape {
+		t.Errorf("constant: error %v, expected errMatrixShape", err)
+	}
+	if _, err := NewPolyInts(1, 2).CompanionMatrix(nil); err != errNotInvertible {
+		t.Errorf("2x + 1 over Z: error %v, expected errNotInvertible", err)
+	}
+	if _, err := MatrixPower(c, big.NewInt(-1), m); err != errNegativeExponent {
+		t.Errorf("negative exponent: error %v, expected errNegativeExponent", err)
+	}
+	if _, err := MatrixPower(c[:2], big.NewInt(2), m); err != errMatrixShape {
+		t.Errorf("non-square: error %v, expected errMatrixShape", err)
+	}
+}
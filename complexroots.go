@@ -0,0 +1,149 @@
+package polynomial
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+// Complex is a complex number with big.Float parts
+type Complex struct {
+	Re, Im *big.Float
+}
+
+// ComplexRoot is an approximate root with |P(Root)| as its residual
+type ComplexRoot struct {
+	Root     Complex
+	Residual *big.Float
+}
+
+var errNoRoots = errors.New("polynomial: a constant polynomial has no roots")
+
+func newComplex(prec uint) Complex {
+	return Complex{new(big.Float).SetPrec(prec), new(big.Float).SetPrec(prec)}
+}
+
+func (z Complex) add(w Complex) Complex {
+	r := newComplex(z.Re.Prec())
+	r.Re.Add(z.Re, w.Re)
+	r.Im.Add(z.Im, w.Im)
+	return r
+}
+
+func (z Complex) sub(w Complex) Complex {
+	r := newComplex(z.Re.Prec())
+	r.Re.Sub(z.Re, w.Re)
+	r.Im.Sub(z.Im, w.Im)
+	return r
+}
+
+func (z Complex) mul(w Complex) Complex {
+	prec := z.Re.Prec()
+	r := newComplex(prec)
+	t := new(big.Float).SetPrec(prec)
+	r.Re.Mul(z.Re, w.Re)
+	r.Re.Sub(r.Re, t.Mul(z.Im, w.Im))
+	r.Im.Mul(z.Re, w.Im)
+	r.Im.Add(r.Im, t.Mul(z.Im, w.Re))
+	return r
+}
+
+// quo() returns z / w, or false if w = 0
+func (z Complex) quo(w Complex) (Complex, bool) {
+	d := w.abs2()
+	if d.Sign() == 0 {
+		return Complex{}, false
+	}
+	conj := Complex{w.Re, new(big.Float).Neg(w.Im)}
+	r := z.mul(conj)
+	r.Re.Quo(r.Re, d)
+	r.Im.Quo(r.Im, d)
+	return r, true
+}
+
+func (z Complex) abs2() *big.Float {
+	prec := z.Re.Prec()
+	a := new(big.Float).SetPrec(prec).Mul(z.Re, z.Re)
+	return a.Add(a, new(big.Float).SetPrec(prec).Mul(z.Im, z.Im))
+}
+
+// Abs() returns |z|
+func (z Complex) Abs() *big.Float {
+	return new(big.Float).SetPrec(z.Re.Prec()).Sqrt(z.abs2())
+}
+
+// evalComplex() returns P(z) by Horner's method
+func evalComplex(coeffs []*big.Float, z Complex) Complex {
+	y := newComplex(z.Re.Prec())
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y = y.mul(z)
+		y.Re.Add(y.Re, coeffs[i])
+	}
+	return y
+}
+
+// ComplexRoots() approximates all complex roots of P (with multiplicity) to about prec bits (Durand-Kerner)
+// The iteration z_k <- z_k - P(z_k) / (a_n prod over j != k of (z_k - z_j)) starts on a circle of radius RootBound()
+// and stops when every correction is below 2^-prec max(1, |z_k|) or after an iteration limit;
+// multiple roots converge slowly, so the residuals |P(z_k)| tell how good each root is
+func (p Poly) ComplexRoots(prec uint) ([]ComplexRoot, error) {
+	q := p.Clone(0)
+	q.trim()
+	n := q.GetDegree()
+	if n < 1 {
+		return nil, errNoRoots
+	}
+	work := prec + 32
+	coeffs := make([]*big.Float, n+1)
+	for i, c := range q {
+		coeffs[i] = new(big.Float).SetPrec(work).SetInt(c)
+	}
+	lead := Complex{coeffs[n], new(big.Float).SetPrec(work)}
+	radius, _ := new(big.Float).SetRat(q.RootBound()).Float64()
+	radius = math.Max(radius, 1)
+	zs := make([]Complex, n)
+	for k := range zs {
+		theta := 2*math.Pi*float64(k)/float64(n) + 0.4
+		zs[k] = Complex{
+			new(big.Float).SetPrec(work).SetFloat64(radius * math.Cos(theta)),
+			new(big.Float).SetPrec(work).SetFloat64(radius * math.Sin(theta)),
+		}
+	}
+	eps := new(big.Float).SetPrec(work).SetMantExp(big.NewFloat(1), -int(prec))
+	one := new(big.Float).SetPrec(work).SetInt64(1)
+	for iter := 0; iter < 100+8*int(prec); iter++ {
+		done := true
+		for k := range zs {
+			den := lead
+			for j := range zs {
+				if j != k {
+					den = den.mul(zs[k].sub(zs[j]))
+				}
+			}
+			step, ok := evalComplex(coeffs, zs[k]).quo(den)
+			if !ok {
+				// two estimates collided; nudge one of them
+				zs[k].Im.Add(zs[k].Im, eps)
+				done = false
+				continue
+			}
+			zs[k] = zs[k].sub(step)
+			tol := zs[k].Abs()
+			if tol.Cmp(one) < 0 {
+				tol.Set(one)
+			}
+			if step.Abs().Cmp(tol.Mul(tol, eps)) > 0 {
+				done = false
+			}
+		}
+		if done {
+			break
+		}
+	}
+	roots := make([]ComplexRoot, n)
+	for k, z := range zs {
+		r := Complex{new(big.Float).SetPrec(prec).Set(z.Re), new(big.Float).SetPrec(prec).Set(z.Im)}
+		roots[k] = ComplexRoot{r, evalComplex(coeffs, z).Abs()}
+	}
+	return roots, nil
+}
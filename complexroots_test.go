@@ -0,0 +1,56 @@
+package polynomial
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"testing"
+)
+
+func TestComplexRoots(t *testing.T) {
+	cases := []struct {
+		p    Poly
+		want [][2]float64
+	}{
+		{NewPolyInts(1, 0, 1), [][2]float64{{0, -1}, {0, 1}}},
+		{Wilkinson(5), [][2]float64{{1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0}}},
+		{NewPolyInts(-1, 0, 0, 1), [][2]float64{{-0.5, -math.Sqrt(3) / 2}, {-0.5, math.Sqrt(3) / 2}, {1, 0}}},
+		{NewPolyInts(-6, 4), [][2]float64{{1.5, 0}}},
+		{NewPolyInts(0, 0, 1, 1), [][2]float64{{-1, 0}, {0, 0}, {0, 0}}},
+	}
+	for _, c := range cases {
+		roots, err := c.p.ComplexRoots(64)
+		if err != nil || len(roots) != len(c.want) {
+			t.Errorf("ComplexRoots(%v) = %v, %v", c.p, roots, err)
+			continue
+		}
+		got := make([][2]float64, len(roots))
+		for i, r := range roots {
+			got[i][0], _ = r.Root.Re.Float64()
+			got[i][1], _ = r.Root.Im.Float64()
+		}
+		sort.Slice(got, func(i, j int) bool {
+			if math.Abs(got[i][0]-got[j][0]) > 1e-6 {
+				return got[i][0] < got[j][0]
+			}
+			return got[i][1] < got[j][1]
+		})
+		for i := range got {
+			if math.Abs(got[i][0]-c.want[i][0]) > 1e-6 || math.Abs(got[i][1]-c.want[i][1]) > 1e-6 {
+				t.Errorf("ComplexRoots(%v) = %v, want %v", c.p, got, c.want)
+				break
+			}
+		}
+	}
+
+	// simple roots converge to the requested precision
+	roots, _ := NewPolyInts(-2, 0, 1).ComplexRoots(128)
+	for _, r := range roots {
+		if r.Residual.Cmp(new(big.Float).SetMantExp(big.NewFloat(1), -120)) > 0 {
+			t.Errorf("the residual at %v is %v", r.Root.Re, r.Residual)
+		}
+	}
+	if _, err := NewPolyInts(3).ComplexRoots(64); err == nil {
+		t.Errorf("a constant should have no roots")
+	}
+}
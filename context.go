@@ -0,0 +1,61 @@
+package polynomial
+
+import (
+	"context"
+	"math/big"
+)
+
+// ctxBlock is the number of coefficients of the longer operand multiplied between two checks of ctx.Done()
+const ctxBlock = 4096
+
+// MulCtx() returns P * Q like Mul(), or ctx.Err() if ctx is done first
+// The longer operand is cut into blocks of ctxBlock coefficients and ctx is checked between the block products
+func (p Poly) MulCtx(ctx context.Context, q Poly, m *big.Int) (Poly, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(p) < len(q) {
+		p, q = q, p
+	}
+	if len(p) <= ctxBlock {
+		return p.Mul(q, m), nil
+	}
+	r := make(Poly, len(p)+len(q)-1)
+	for i := range r {
+		r[i] = new(big.Int)
+	}
+	for lo := 0; lo < len(p); lo += ctxBlock {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		hi := lo + ctxBlock
+		if hi > len(p) {
+			hi = len(p)
+		}
+		for i, c := range Poly(p[lo:hi]).Mul(q, m) {
+			r[lo+i].Add(r[lo+i], c)
+			if m != nil {
+				r[lo+i].Mod(r[lo+i], m)
+			}
+		}
+	}
+	r.trim()
+	return r, nil
+}
+
+// GcdCtx() returns gcd(P, Q) like Gcd(), or ctx.Err() if ctx is done first
+// ctx is checked before every division step of the Euclidean algorithm
+func (p Poly) GcdCtx(ctx context.Context, q Poly, m *big.Int) (Poly, error) {
+	for {
+		if p.Compare(&q) < 0 {
+			p, q = q, p
+		}
+		if q.isZero() {
+			return p, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		p, q = q, p.Rem(q, m)
+	}
+}
@@ -0,0 +1,63 @@
+package polynomial
+
+import (
+	"context"
+	"math/big"
+	"testing"
+)
+
+func TestMulCtx(t *testing.T) {
+	m := big.NewInt(1000003)
+	p := RandomPoly(2*ctxBlock+10, 20)
+	q := RandomPoly(300, 20)
+	p.sanitize(m)
+	q.sanitize(m)
+	cases := []struct {
+		p, q Poly
+		m    *big.Int
+	}{
+		{p, q, m},
+		{q, p, m},
+		{NewPolyInts(1, 2, 3), NewPolyInts(4, 5), nil},
+	}
+	for _, c := range cases {
+		got, err := c.p.Clone(0).MulCtx(context.Background(), c.q.Clone(0), c.m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := c.p.Clone(0).Mul(c.q.Clone(0), c.m); !got.Equal(want) {
+			t.Errorf("MulCtx() of degrees %d and %d differs from Mul()", c.p.GetDegree(), c.q.GetDegree())
+		}
+	}
+}
+
+func TestGcdCtx(t *testing.T) {
+	m := big.NewInt(7)
+	// (x + 1)(x + 2) and (x + 1)(x + 3)
+	p := NewPolyInts(2, 3, 1)
+	q := NewPolyInts(3, 4, 1)
+	got, err := p.Clone(0).GcdCtx(context.Background(), q.Clone(0), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := p.Clone(0).Gcd(q.Clone(0), m); !got.Equal(want) {
+		t.Errorf("GcdCtx() = %v, expected %v", got, want)
+	}
+}
+
+func TestCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	m := big.NewInt(7)
+	p := NewPolyInts(2, 3, 1)
+	q := NewPolyInts(3, 4, 1)
+	if _, err := p.MulCtx(ctx, q, m); err != context.Canceled {
+		t.Errorf("MulCtx() error %v, expected context.Canceled", err)
+	}
+	if _, err := p.GcdCtx(ctx, q, m); err != context.Canceled {
+		t.Errorf("GcdCtx() error %v, expected context.Canceled", err)
+	}
+	if _, err := NewPolyInts(-1, 0, 0, 0, 1).FactorCtx(ctx, big.NewInt(5)); err != context.Canceled {
+		t.Errorf("FactorCtx() error %v, expected context.Canceled", err)
+	}
+}
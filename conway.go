@@ -0,0 +1,193 @@
+package polynomial
+
+// conwayTable holds the Conway polynomials C_(p,n) as ascending coefficients, in the convention of
+// Frank Lübeck's tables used by Sage, Magma and GAP: the lexicographically least primitive polynomial,
+// with the signs of every other coefficient alternated, whose roots are compatible with those of C_(p,m) for m | n
+var conwayTable = map[int][][]int{
+	2: {
+		{1, 1},
+		{1, 1, 1},
+		{1, 1, 0, 1},
+		{1, 1, 0, 0, 1},
+		{1, 0, 1, 0, 0, 1},
+		{1, 1, 0, 1, 1, 0, 1},
+		{1, 1, 0, 0, 0, 0, 0, 1},
+		{1, 0, 1, 1, 1, 0, 0, 0, 1},
+		{1, 0, 0, 0, 1, 0, 0, 0, 0, 1},
+		{1, 1, 1, 1, 0, 1, 1, 0, 0, 0, 1},
+		{1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		{1, 1, 0, 1, 0, 1, 1, 1, 0, 0, 0, 0, 1},
+		{1, 1, 0, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		{1, 0, 0, 1, 0, 1, 0, 1, 0, 0, 0, 0, 0, 0, 1},
+		{1, 0, 1, 0, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		{1, 0, 1, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+	},
+	3: {
+		{1, 1},
+		{2, 2, 1},
+		{1, 2, 0, 1},
+		{2, 0, 0, 2, 1},
+		{1, 2, 0, 0, 0, 1},
+		{2, 2, 1, 0, 2, 0, 1},
+		{1, 0, 2, 0, 0, 0, 0, 1},
+		{2, 2, 2, 0, 1, 2, 0, 0, 1},
+		{1, 1, 2, 2, 0, 0, 0, 0, 0, 1},
+		{2, 1, 0, 0, 2, 2, 2, 0, 0, 0, 1},
+	},
+	5: {
+		{3, 1},
+		{2, 4, 1},
+		{3, 3, 0, 1},
+		{2, 4, 4, 0, 1},
+		{3, 4, 0, 0, 0, 1},
+		{2, 0, 1, 4, 1, 0, 1},
+		{3, 3, 0, 0, 0, 0, 0, 1},
+	},
+	7: {
+		{4, 1},
+		{3, 6, 1},
+		{4, 0, 6, 1},
+		{3, 4, 5, 0, 1},
+		{4, 1, 0, 0, 0, 1},
+		{3, 6, 4, 5, 1, 0, 1},
+	},
+	11: {
+		{9, 1},
+		{2, 7, 1},
+		{9, 2, 0, 1},
+		{2, 10, 8, 0, 1},
+		{9, 0, 10, 0, 0, 1},
+	},
+	13: {
+		{11, 1},
+		{2, 12, 1},
+		{11, 2, 0, 1},
+		{2, 12, 3, 0, 1},
+		{11, 4, 0, 0, 0, 1},
+	},
+	17: {
+		{14, 1},
+		{3, 16, 1},
+		{14, 1, 0, 1},
+		{3, 10, 7, 0, 1},
+	},
+	19: {
+		{17, 1},
+		{2, 18, 1},
+		{17, 4, 0, 1},
+		{2, 11, 2, 0, 1},
+	},
+	23: {
+		{18, 1},
+		{5, 21, 1},
+		{18, 2, 0, 1},
+		{5, 19, 3, 0, 1},
+	},
+	29: {
+		{27, 1},
+		{2, 24, 1},
+		{27, 2, 0, 1},
+		{2, 15, 2, 0, 1},
+	},
+	31: {
+		{28, 1},
+		{3, 29, 1},
+		{28, 1, 0, 1},
+		{3, 16, 3, 0, 1},
+	},
+	37: {
+		{35, 1},
+		{2, 33, 1},
+		{35, 6, 0, 1},
+		{2, 24, 6, 0, 1},
+	},
+	41: {
+		{35, 1},
+		{6, 38, 1},
+		{35, 1, 0, 1},
+		{6, 23, 0, 0, 1},
+	},
+	43: {
+		{40, 1},
+		{3, 42, 1},
+		{40, 1, 0, 1},
+		{3, 42, 5, 0, 1},
+	},
+	47: {
+		{42, 1},
+		{5, 45, 1},
+		{42, 3, 0, 1},
+		{5, 40, 8, 0, 1},
+	},
+	53: {
+		{51, 1},
+		{2, 49, 1},
+		{51, 3, 0, 1},
+		{2, 38, 9, 0, 1},
+	},
+	59: {
+		{57, 1},
+		{2, 58, 1},
+		{57, 5, 0, 1},
+		{2, 40, 2, 0, 1},
+	},
+	61: {
+		{59, 1},
+		{2, 60, 1},
+		{59, 7, 0, 1},
+		{2, 40, 3, 0, 1},
+	},
+	67: {
+		{65, 1},
+		{2, 63, 1},
+		{65, 6, 0, 1},
+		{2, 54, 8, 0, 1},
+	},
+	71: {
+		{64, 1},
+		{7, 69, 1},
+		{64, 4, 0, 1},
+		{7, 41, 4, 0, 1},
+	},
+	73: {
+		{68, 1},
+		{5, 70, 1},
+		{68, 2, 0, 1},
+		{5, 56, 16, 0, 1},
+	},
+	79: {
+		{76, 1},
+		{3, 78, 1},
+		{76, 9, 0, 1},
+		{3, 66, 2, 0, 1},
+	},
+	83: {
+		{81, 1},
+		{2, 82, 1},
+		{81, 3, 0, 1},
+		{2, 42, 4, 0, 1},
+	},
+	89: {
+		{86, 1},
+		{3, 82, 1},
+		{86, 3, 0, 1},
+		{3, 72, 4, 0, 1},
+	},
+	97: {
+		{92, 1},
+		{5, 96, 1},
+		{92, 9, 0, 1},
+		{5, 80, 6, 0, 1},
+	},
+}
+
+// LookupConway() returns the Conway polynomial C_(p,n) from the bundled table
+// The table covers primes below 100, with n up to 16 for p = 2, 10 for p = 3, 7 for p = 5,
+// 6 for p = 7, 5 for p = 11 and 13, and 4 otherwise
+func LookupConway(p, n int) (Poly, bool) {
+	rows, ok := conwayTable[p]
+	if !ok || n < 1 || n > len(rows) {
+		return nil, false
+	}
+	return NewPolyInts(rows[n-1]...), true
+}
@@ -0,0 +1,89 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestLookupConway(t *testing.T) {
+	cases := []struct {
+		p, n int
+		want Poly
+	}{
+		{2, 1, NewPolyInts(1, 1)},
+		{2, 8, NewPolyInts(1, 0, 1, 1, 1, 0, 0, 0, 1)},
+		{3, 2, NewPolyInts(2, 2, 1)},
+		{5, 3, NewPolyInts(3, 3, 0, 1)},
+		{7, 1, NewPolyInts(4, 1)},
+	}
+	for _, c := range cases {
+		if got, ok := LookupConway(c.p, c.n); !ok || got.Compare(&c.want) != 0 {
+			t.Errorf("LookupConway(%d, %d) = %v, want %v", c.p, c.n, got, c.want)
+		}
+	}
+	for _, c := range [][2]int{{4, 1}, {2, 0}, {2, 17}, {101, 1}} {
+		if _, ok := LookupConway(c[0], c[1]); ok {
+			t.Errorf("LookupConway(%d, %d) should not be in the table", c[0], c[1])
+		}
+	}
+}
+
+// primeFactors() returns the distinct prime factors of n by trial division
+func primeFactors(n *big.Int) []*big.Int {
+	var fs []*big.Int
+	n = new(big.Int).Set(n)
+	r := new(big.Int)
+	for d := big.NewInt(2); new(big.Int).Mul(d, d).Cmp(n) <= 0; d.Add(d, big.NewInt(1)) {
+		if r.Mod(n, d).Sign() != 0 {
+			continue
+		}
+		fs = append(fs, new(big.Int).Set(d))
+		for r.Mod(n, d).Sign() == 0 {
+			n.Quo(n, d)
+		}
+	}
+	if n.Cmp(big.NewInt(1)) > 0 {
+		fs = append(fs, n)
+	}
+	return fs
+}
+
+func TestConwayTable(t *testing.T) {
+	x := NewPolyInts(0, 1)
+	one := NewPolyInts(1)
+	for p, rows := range conwayTable {
+		m := big.NewInt(int64(p))
+		for i := range rows {
+			n := i + 1
+			f, _ := LookupConway(p, n)
+			if f.GetDegree() != n || !f.IsMonic(m) {
+				t.Errorf("C_(%d,%d) = %v is not monic of degree %d", p, n, f, n)
+				continue
+			}
+			// x has order p^n - 1 modulo C_(p,n), so C_(p,n) is primitive
+			order := new(big.Int).Exp(m, big.NewInt(int64(n)), nil)
+			order.Sub(order, big.NewInt(1))
+			if r := x.PowMod(order, f, m); r.Compare(&one) != 0 {
+				t.Errorf("C_(%d,%d) does not divide x^(p^n - 1) - 1", p, n)
+			}
+			for _, q := range primeFactors(order) {
+				if r := x.PowMod(new(big.Int).Quo(order, q), f, m); r.Compare(&one) == 0 {
+					t.Errorf("C_(%d,%d) is not primitive", p, n)
+				}
+			}
+			// C_(p,d)(x^((p^n - 1) / (p^d - 1))) = 0 modulo C_(p,n) for d | n
+			for d := 1; d < n; d++ {
+				if n%d != 0 {
+					continue
+				}
+				sub, _ := LookupConway(p, d)
+				e := new(big.Int).Exp(m, big.NewInt(int64(d)), nil)
+				e.Quo(order, e.Sub(e, big.NewInt(1)))
+				_, r := sub.Compose(x.PowMod(e, f, m), m).Div(f.Clone(0), m)
+				if !r.isZero() {
+					t.Errorf("C_(%d,%d) is not compatible with C_(%d,%d)", p, n, p, d)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+var errCRTModuli = errors.New("polynomial: CRT moduli must be pairwise coprime and in [2, 2^63)")
+
+// CRTBasis holds word-sized pairwise coprime moduli p_i and the constants of the Chinese remainder theorem
+// for their product M: every integer in [-M/2, M/2) is determined by its residues
+type CRTBasis struct {
+	Primes []uint64
+	M      *big.Int
+	mi     []*big.Int // M / p_i
+	yi     []*big.Int // (M / p_i)^-1 mod p_i
+}
+
+// NewCRTBasis() checks the moduli (pairwise coprime, in [2, 2^63)) and precomputes the reconstruction constants
+func NewCRTBasis(primes []uint64) (*CRTBasis, error) {
+	if len(primes) == 0 {
+		return nil, errCRTModuli
+	}
+	b := &CRTBasis{Primes: append([]uint64(nil), primes...), M: big.NewInt(1)}
+	for _, p := range primes {
+		// the residue additions in mulResidue() and addResidue() rely on p < 2^63
+		if p < 2 || p >= 1<<63 {
+			return nil, errCRTModuli
+		}
+		b.M.Mul(b.M, new(big.Int).SetUint64(p))
+	}
+	for _, p := range primes {
+		bp := new(big.Int).SetUint64(p)
+		mi := new(big.Int).Quo(b.M, bp)
+		yi := new(big.Int).ModInverse(new(big.Int).Mod(mi, bp), bp)
+		if yi == nil {
+			return nil, errCRTModuli
+		}
+		b.mi = append(b.mi, mi)
+		b.yi = append(b.yi, yi)
+	}
+	return b, nil
+}
+
+// WordPrimes() returns the n largest primes below 2^62, which leave room for lazy additions in uint64
+func WordPrimes(n int) []uint64 {
+	ps := make([]uint64, 0, n)
+	c := new(big.Int)
+	for p := uint64(1<<62 - 1); len(ps) < n; p -= 2 {
+		if c.SetUint64(p).ProbablyPrime(20) {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+// Reduce() returns the residue system of P: res[i][j] = p_j mod Primes[i], with negative coefficients mapped
+// into [0, p_i)
+func (b *CRTBasis) Reduce(p Poly) [][]uint64 {
+	res := make([][]uint64, len(b.Primes))
+	c, bp := new(big.Int), new(big.Int)
+	for i, pr := range b.Primes {
+		bp.SetUint64(pr)
+		res[i] = make([]uint64, len(p))
+		for j, a := range p {
+			res[i][j] = c.Mod(a, bp).Uint64()
+		}
+	}
+	return res
+}
+
+// Apply() replaces every residue polynomial by f(i, Primes[i], res[i]), e.g. a product computed with word-sized
+// arithmetic; f must return coefficients reduced modulo its prime
+func (b *CRTBasis) Apply(res [][]uint64, f func(i int, p uint64, coeffs []uint64) []uint64) [][]uint64 {
+	out := make([][]uint64, len(res))
+	for i, r := range res {
+		out[i] = f(i, b.Primes[i], r)
+	}
+	return out
+}
+
+// Reconstruct() lifts a residue system back to a polynomial over Z
+// x = sum r_i (M / p_i) ((M / p_i)^-1 mod p_i) mod M, and with signed set the result is mapped into [-M/2, M/2),
+// which is correct whenever every true coefficient has absolute value below M/2
+func (b *CRTBasis) Reconstruct(res [][]uint64, signed bool) (Poly, error) {
+	if len(res) != len(b.Primes) {
+		return nil, errMatrixShape
+	}
+	n := len(res[0])
+	for _, r := range res {
+		if len(r) != n {
+			return nil, errMatrixShape
+		}
+	}
+	p := make(Poly, n)
+	t, x2 := new(big.Int), new(big.Int)
+	for j := range p {
+		x := new(big.Int)
+		for i := range res {
+			t.SetUint64(res[i][j])
+			t.Mul(t, b.yi[i])
+			t.Mod(t, new(big.Int).SetUint64(b.Primes[i]))
+			x.Add(x, t.Mul(t, b.mi[i]))
+		}
+		x.Mod(x, b.M)
+		// 2x >= M rather than x >= M >> 1, which is one too small for odd M
+		if signed && x2.Lsh(x, 1).Cmp(b.M) >= 0 {
+			x.Sub(x, b.M)
+		}
+		p[j] = x
+	}
+	if n == 0 {
+		return NewPolyInts(0), nil
+	}
+	p.trim()
+	return p, nil
+}
+
+// mulMod64() returns a b mod p for a, b < p
+func mulMod64(a, b, p uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, r := bits.Div64(hi, lo, p)
+	return r
+}
+
+// mulResidue() returns the product of two residue polynomials modulo p
+func mulResidue(a, b []uint64, p uint64) []uint64 {
+	r := make([]uint64, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			// both terms are below p < 2^63 (checked by NewCRTBasis()), so the sum does not overflow
+			s := r[i+j] + mulMod64(x, y, p)
+			if s >= p {
+				s -= p
+			}
+			r[i+j] = s
+		}
+	}
+	return r
+}
+
+// MulCRT() returns P * Q over Z computed modulo enough word-sized primes and lifted back by CRT
+// The coefficients of the product are bounded by min(len P, len Q) |P|_inf |Q|_inf, which fixes the number of primes
+func (p Poly) MulCRT(q Poly) Poly {
+	p, q = p.Clone(0), q.Clone(0)
+	p.trim()
+	q.trim()
+	n := len(p)
+	if len(q) < n {
+		n = len(q)
+	}
+	bound := new(big.Int).Mul(p.NormInf(), q.NormInf())
+	bound.Mul(bound, big.NewInt(int64(n)))
+	// M > 2 bound, and each prime contributes more than 61 bits
+	count := (bound.BitLen()+1)/61 + 1
+	b, _ := NewCRTBasis(WordPrimes(count))
+	rq := b.Reduce(q)
+	res := b.Apply(b.Reduce(p), func(i int, pr uint64, a []uint64) []uint64 {
+		return mulResidue(a, rq[i], pr)
+	})
+	r, _ := b.Reconstruct(res, true)
+	return r
+}
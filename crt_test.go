@@ -0,0 +1,72 @@
+package polynomial
+
+import "testing"
+
+func TestCRTBasis(t *testing.T) {
+	b, err := NewCRTBasis([]uint64{3, 5, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.M.Int64() != 105 {
+		t.Errorf("M = %v, expected 105", b.M)
+	}
+	p := NewPolyInts(-52, 0, 17, 51, -1)
+	res := b.Reduce(p)
+	if res[0][0] != 2 || res[1][0] != 3 || res[2][0] != 4 || res[2][4] != 6 {
+		t.Errorf("Reduce(%v) = %v", p, res)
+	}
+	cases := []struct {
+		signed bool
+		want   Poly
+	}{
+		{true, p},
+		{false, NewPolyInts(53, 0, 17, 51, 104)},
+	}
+	for _, c := range cases {
+		got, err := b.Reconstruct(res, c.signed)
+		if err != nil || !got.Equal(c.want) {
+			t.Errorf("Reconstruct(signed = %v) = %v, %v, expected %v", c.signed, got, err, c.want)
+		}
+	}
+	// (M - 1) / 2 is below M / 2 for odd M and must stay positive
+	edge := NewPolyInts(52, -52)
+	if got, err := b.Reconstruct(b.Reduce(edge), true); err != nil || !got.Equal(edge) {
+		t.Errorf("Reconstruct(Reduce(%v)) = %v, %v", edge, got, err)
+	}
+	if _, err := b.Reconstruct(res[:2], true); err != errMatrixShape {
+		t.Errorf("Reconstruct() with missing residues: error %v, expected errMatrixShape", err)
+	}
+	for _, ps := range [][]uint64{nil, {6, 9}, {1, 5}, {3, 1<<63 + 1}} {
+		if _, err := NewCRTBasis(ps); err != errCRTModuli {
+			t.Errorf("NewCRTBasis(%v): error %v, expected errCRTModuli", ps, err)
+		}
+	}
+}
+
+func TestWordPrimes(t *testing.T) {
+	ps := WordPrimes(3)
+	// 2^62 - 57, 2^62 - 87 and 2^62 - 117 are the three largest primes below 2^62
+	want := []uint64{1<<62 - 57, 1<<62 - 87, 1<<62 - 117}
+	for i := range want {
+		if ps[i] != want[i] {
+			t.Errorf("WordPrimes(3) = %v, expected %v", ps, want)
+			break
+		}
+	}
+}
+
+func TestMulCRT(t *testing.T) {
+	cases := []struct {
+		p, q Poly
+	}{
+		{NewPolyInts(1, -2, 3), NewPolyInts(-4, 5)},
+		{NewPolyInts(0), NewPolyInts(1, 1)},
+		{NewPolyInts(0).Sub(RandomPoly(40, 200), nil), RandomPoly(33, 300)},
+	}
+	for _, c := range cases {
+		want := c.p.Clone(0).Mul(c.q.Clone(0), nil)
+		if got := c.p.MulCRT(c.q); !got.Equal(want) {
+			t.Errorf("MulCRT() of degrees %d and %d differs from Mul()", c.p.GetDegree(), c.q.GetDegree())
+		}
+	}
+}
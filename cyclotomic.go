@@ -0,0 +1,45 @@
+package polynomial
+
+// Cyclotomic() returns the n-th cyclotomic polynomial over Z
+// Möbius inversion: Phi_n = prod over d | n of (x^d - 1)^mu(n/d)
+// n must be positive
+func Cyclotomic(n int) Poly {
+	if n < 1 {
+		panic("polynomial: the cyclotomic index must be positive")
+	}
+	num, den := NewPolyInts(1), NewPolyInts(1)
+	for d := 1; d <= n; d++ {
+		if n%d != 0 {
+			continue
+		}
+		xd := NewPolyInts(-1).Add(NewPolyInts(1).Clone(d), nil)
+		switch mobius(n / d) {
+		case 1:
+			num = num.Mul(xd, nil)
+		case -1:
+			den = den.Mul(xd, nil)
+		}
+	}
+	// den is monic, so the division is exact over Z
+	phi, _ := num.Div(den, nil)
+	return phi
+}
+
+// mobius() returns the Möbius function mu(n)
+func mobius(n int) int {
+	mu := 1
+	for q := 2; q*q <= n; q++ {
+		if n%q != 0 {
+			continue
+		}
+		n /= q
+		if n%q == 0 {
+			return 0
+		}
+		mu = -mu
+	}
+	if n > 1 {
+		mu = -mu
+	}
+	return mu
+}
@@ -0,0 +1,47 @@
+package polynomial
+
+import "testing"
+
+func TestCyclotomic(t *testing.T) {
+	cases := []struct {
+		n    int
+		want Poly
+	}{
+		{1, NewPolyInts(-1, 1)},
+		{2, NewPolyInts(1, 1)},
+		{4, NewPolyInts(1, 0, 1)},
+		{6, NewPolyInts(1, -1, 1)},
+		{8, NewPolyInts(1, 0, 0, 0, 1)},
+		{12, NewPolyInts(1, 0, -1, 0, 1)},
+		{15, NewPolyInts(1, -1, 0, 1, -1, 1, 0, -1, 1)},
+		// the first cyclotomic polynomial with a coefficient other than 0 and +-1
+		{105, NewPolyInts(1, 1, 1, 0, 0, -1, -1, -2, -1, -1, 0, 0, 1, 1, 1, 1, 1, 1, 0, 0, -1, 0, -1, 0, -1, 0, -1, 0, -1, 0, 0, 1, 1, 1, 1, 1, 1, 0, 0, -1, -1, -2, -1, -1, 0, 0, 1, 1, 1)},
+	}
+	for _, c := range cases {
+		if got := Cyclotomic(c.n); got.Compare(&c.want) != 0 {
+			t.Errorf("Cyclotomic(%d) = %v, want %v", c.n, got, c.want)
+		}
+	}
+
+	// x^n - 1 is the product of Phi_d over the divisors d of n
+	for n := 1; n <= 30; n++ {
+		prod := NewPolyInts(1)
+		for d := 1; d <= n; d++ {
+			if n%d == 0 {
+				prod = prod.Mul(Cyclotomic(d), nil)
+			}
+		}
+		if want := NewPolyInts(-1).Add(NewPolyInts(1).Clone(n), nil); prod.Compare(&want) != 0 {
+			t.Errorf("the product of Phi_d for d | %d is %v", n, prod)
+		}
+	}
+}
+
+func TestMobius(t *testing.T) {
+	want := []int{1, -1, -1, 0, -1, 1, -1, 0, 0, 1, -1, 0}
+	for i, w := range want {
+		if got := mobius(i + 1); got != w {
+			t.Errorf("mobius(%d) = %d, want %d", i+1, got, w)
+		}
+	}
+}
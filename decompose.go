@@ -0,0 +1,108 @@
+package polynomial
+
+import "math/big"
+
+// Compose() returns P(Q(x)) (Horner's method)
+// modulo m can be nil
+func (p Poly) Compose(q Poly, m *big.Int) Poly {
+	r := NewPolyInts(0)
+	for i := p.GetDegree(); i >= 0; i-- {
+		r = r.Mul(q.Clone(0), m).Add(Poly{new(big.Int).Set(p[i])}, m)
+	}
+	return r
+}
+
+// Decompose() returns f and g with P = f(g(x)), 1 < deg g < deg P, and true if such a decomposition exists
+// g is normalized to be monic with g(0) = 0; over Z (m = nil) only integral f and g of this form are found
+// Kozen-Landau: for each degree s of g, the top s coefficients of P determine g as the polynomial part
+// of P^(1/r) where r = deg P / s, and f is read off the expansion of P in base g
+// Modulo a prime m, only tame degrees (m does not divide r, s < m) are tried
+func Decompose(p Poly, m *big.Int) (f, g Poly, ok bool) {
+	p = p.Clone(0)
+	p.sanitize(m)
+	p.trim()
+	n := p.GetDegree()
+	for s := 2; s < n; s++ {
+		if n%s != 0 {
+			continue
+		}
+		if f, g, ok = decomposeDegree(p, n/s, s, m); ok {
+			return f, g, true
+		}
+	}
+	return nil, nil, false
+}
+
+// decomposeDegree() looks for P = f(g(x)) with deg f = r and deg g = s
+func decomposeDegree(p Poly, r, s int, m *big.Int) (Poly, Poly, bool) {
+	norm := func(x *big.Rat) (*big.Rat, bool) {
+		if m == nil {
+			return x, true
+		}
+		v, ok := ratMod(x, m)
+		if !ok {
+			return nil, false
+		}
+		return new(big.Rat).SetInt(v), true
+	}
+	n := r * s
+	lead, ok := norm(new(big.Rat).SetFrac(big.NewInt(1), p[n]))
+	if !ok {
+		return nil, nil, false
+	}
+	// P_j = p_(n-j) / lead(p) is the reversed monic series and G = P^a with a = 1/r
+	a, ok := norm(big.NewRat(1, int64(r)))
+	if !ok || (m != nil && m.Cmp(big.NewInt(int64(s))) <= 0) {
+		return nil, nil, false
+	}
+	rev := make([]*big.Rat, s+1)
+	for j := range rev {
+		rev[j], _ = norm(new(big.Rat).Mul(new(big.Rat).SetInt(p[n-j]), lead))
+	}
+	// J.C.P. Miller: G_k = 1/k * sum_(j=1..k) ((a + 1) j - k) P_j G_(k-j)
+	root := make([]*big.Rat, s+1)
+	root[0] = big.NewRat(1, 1)
+	for k := 1; k <= s; k++ {
+		sum := new(big.Rat)
+		for j := 1; j <= k; j++ {
+			c := new(big.Rat).Add(a, big.NewRat(1, 1))
+			c.Mul(c, big.NewRat(int64(j), 1)).Sub(c, big.NewRat(int64(k), 1))
+			sum.Add(sum, c.Mul(c, rev[j]).Mul(c, root[k-j]))
+		}
+		if root[k], ok = norm(sum.Quo(sum, big.NewRat(int64(k), 1))); !ok {
+			return nil, nil, false
+		}
+	}
+	// g(x) = x^s G(1/x) without its constant term
+	g := make(RatPoly, s+1)
+	g[0] = new(big.Rat)
+	for i := 1; i <= s; i++ {
+		g[i] = root[s-i]
+	}
+	// P = sum f_i g^i, and every digit f_i must be a constant
+	rest := NewRatPoly(p)
+	fr := make(RatPoly, r+1)
+	for i := 0; i <= r; i++ {
+		var digit RatPoly
+		rest, digit = rest.Div(g)
+		for j := range rest {
+			rest[j], _ = norm(rest[j])
+		}
+		for j := range digit {
+			if digit[j], ok = norm(digit[j]); !ok {
+				return nil, nil, false
+			}
+		}
+		if digit.trim(); digit.GetDegree() > 0 {
+			return nil, nil, false
+		}
+		fr[i] = digit[0]
+	}
+	f, okF := fr.ToPoly()
+	gi, okG := g.ToPoly()
+	if !okF || !okG {
+		return nil, nil, false
+	}
+	f.sanitize(m)
+	return f, gi, true
+}
@@ -0,0 +1,54 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCompose(t *testing.T) {
+	// (x^2 + 1) o (x + 1) = x^2 + 2x + 2
+	got := NewPolyInts(1, 0, 1).Compose(NewPolyInts(1, 1), nil)
+	if want := NewPolyInts(2, 2, 1); got.Compare(&want) != 0 {
+		t.Errorf("Compose() = %v, want %v", got, want)
+	}
+	got = NewPolyInts(3).Compose(NewPolyInts(1, 1), nil)
+	if want := NewPolyInts(3); got.Compare(&want) != 0 {
+		t.Errorf("Compose() = %v, want %v", got, want)
+	}
+}
+
+func TestDecompose(t *testing.T) {
+	m := big.NewInt(101)
+	cases := []struct {
+		p  Poly
+		m  *big.Int
+		ok bool
+	}{
+		{NewPolyInts(1, 3, 1).Compose(NewPolyInts(0, 5, 2, 1), nil), nil, true},
+		{NewPolyInts(0, 1, 0, 2).Compose(NewPolyInts(1, 0, 1), nil), nil, true},
+		{NewPolyInts(7, 0, 0, 3).Compose(NewPolyInts(4, -1, 0, 2), nil), nil, false},
+		{NewPolyInts(7, 0, 0, 3).Compose(NewPolyInts(4, -1, 0, 2), m), m, true},
+		{NewPolyInts(5, 1, 1, 1), nil, false},
+		{NewPolyInts(0, 1, 0, 0, 1), nil, false},
+		{NewPolyInts(0, 1, 0, 0, 1), m, false},
+		{NewPolyInts(2, 1, 9).Compose(NewPolyInts(0, 3, 1, 1), m), m, true},
+	}
+	for _, c := range cases {
+		f, g, ok := Decompose(c.p, c.m)
+		if ok != c.ok {
+			t.Errorf("Decompose(%v) mod %v reports %v, want %v", c.p, c.m, ok, c.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if f.GetDegree() < 2 || g.GetDegree() < 2 {
+			t.Errorf("Decompose(%v) = %v o %v is trivial", c.p, f, g)
+		}
+		want := c.p.Clone(0)
+		want.sanitize(c.m)
+		if fg := f.Compose(g, c.m); fg.Compare(&want) != 0 {
+			t.Errorf("Decompose(%v) = %v o %v = %v", c.p, f, g, fg)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package polynomial
+
+import (
+	"encoding/asn1"
+	"errors"
+	"math/big"
+)
+
+var errDER = errors.New("polynomial: malformed or unsupported DER structure")
+
+// derVersion is the only version the DER structures below are written and read with
+const derVersion = 0
+
+// derShare is the DER form of a share:
+//
+//	Share ::= SEQUENCE {
+//	    version INTEGER,  -- 0
+//	    modulus INTEGER,  -- the prime of the sharing
+//	    index   INTEGER,  -- x
+//	    value   INTEGER   -- y = P(x)
+//	}
+type derShare struct {
+	Version int
+	Modulus *big.Int
+	Index   *big.Int
+	Value   *big.Int
+}
+
+// derPoly is the DER form of a polynomial:
+//
+//	Polynomial ::= SEQUENCE {
+//	    version      INTEGER,            -- 0
+//	    modulus      INTEGER,            -- 0 for coefficients over Z
+//	    coefficients SEQUENCE OF INTEGER -- lowest degree first
+//	}
+type derPoly struct {
+	Version int
+	Modulus *big.Int
+	Coeffs  []*big.Int
+}
+
+// derUnmarshal() decodes exactly one DER value into v
+func derUnmarshal(b []byte, v interface{}) error {
+	rest, err := asn1.Unmarshal(b, v)
+	if err != nil || len(rest) != 0 {
+		return errDER
+	}
+	return nil
+}
+
+// MarshalShareDER() encodes a share of a sharing modulo m as a DER Share
+func MarshalShareDER(p Point, m *big.Int) ([]byte, error) {
+	return asn1.Marshal(derShare{derVersion, m, p.x, p.y})
+}
+
+// UnmarshalShareDER() decodes a DER Share and returns the share and its modulus
+func UnmarshalShareDER(b []byte) (Point, *big.Int, error) {
+	var s derShare
+	if err := derUnmarshal(b, &s); err != nil || s.Version != derVersion {
+		return Point{}, nil, errDER
+	}
+	return Point{s.Index, s.Value}, s.Modulus, nil
+}
+
+// MarshalDER() encodes P as a DER Polynomial; m is nil for coefficients over Z
+func (p Poly) MarshalDER(m *big.Int) ([]byte, error) {
+	if m == nil {
+		m = new(big.Int)
+	}
+	return asn1.Marshal(derPoly{derVersion, m, p})
+}
+
+// UnmarshalPolyDER() decodes a DER Polynomial and returns it with its modulus (nil over Z)
+func UnmarshalPolyDER(b []byte) (Poly, *big.Int, error) {
+	var d derPoly
+	if err := derUnmarshal(b, &d); err != nil || d.Version != derVersion || d.Modulus.Sign() < 0 {
+		return nil, nil, errDER
+	}
+	p := Poly(d.Coeffs)
+	if len(p) == 0 {
+		p = NewPolyInts(0)
+	}
+	p.trim()
+	if d.Modulus.Sign() == 0 {
+		return p, nil, nil
+	}
+	return p, d.Modulus, nil
+}
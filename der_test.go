@@ -0,0 +1,59 @@
+package polynomial
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+func TestPolyDER(t *testing.T) {
+	cases := []struct {
+		p   Poly
+		m   *big.Int
+		der string
+	}{
+		{NewPolyInts(1, -2), big.NewInt(7), "300e02010002010730060201010201fe"},
+		{NewPolyInts(0, 128), nil, "300f020100020100300702010002020080"},
+	}
+	for _, c := range cases {
+		b, err := c.p.MarshalDER(c.m)
+		if err != nil || hex.EncodeToString(b) != c.der {
+			t.Errorf("%v.MarshalDER(%v) = %x, %v, expected %s", c.p, c.m, b, err, c.der)
+		}
+		p, m, err := UnmarshalPolyDER(b)
+		if err != nil || !p.Equal(c.p) || (m == nil) != (c.m == nil) || m != nil && m.Cmp(c.m) != 0 {
+			t.Errorf("UnmarshalPolyDER(%x) = %v, %v, %v", b, p, m, err)
+		}
+	}
+	empty, _ := hex.DecodeString("30080201000201073000")
+	if p, _, err := UnmarshalPolyDER(empty); err != nil || !p.Equal(NewPolyInts(0)) {
+		t.Errorf("UnmarshalPolyDER() of no coefficients = %v, %v", p, err)
+	}
+	for _, s := range []string{"", "300e02010102010730060201010201fe", "300e02010002010730060201010201fe00", "30080201000201ff3000", "3003020100"} {
+		b, _ := hex.DecodeString(s)
+		if _, _, err := UnmarshalPolyDER(b); err != errDER {
+			t.Errorf("UnmarshalPolyDER(%s) error = %v", s, err)
+		}
+	}
+}
+
+func TestShareDER(t *testing.T) {
+	q := new(big.Int).Lsh(big.NewInt(1), 127)
+	q.Sub(q, big.NewInt(1))
+	s := NewPoint(big.NewInt(3), new(big.Int).Sub(q, big.NewInt(5)))
+	b, err := MarshalShareDER(s, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(b, []byte{0x30}) {
+		t.Errorf("MarshalShareDER() = %x is not a SEQUENCE", b)
+	}
+	p, m, err := UnmarshalShareDER(b)
+	if err != nil || m.Cmp(q) != 0 || p.X().Cmp(s.X()) != 0 || p.Y().Cmp(s.Y()) != 0 {
+		t.Errorf("UnmarshalShareDER() = %v, %v, %v", p, m, err)
+	}
+	if _, _, err := UnmarshalShareDER(b[:len(b)-1]); err != errDER {
+		t.Errorf("UnmarshalShareDER() of a truncated share error = %v", err)
+	}
+}
@@ -0,0 +1,28 @@
+package polynomial
+
+// SignVariations() counts the sign changes in the coefficient sequence of P, skipping zeros
+func (p Poly) SignVariations() int {
+	v, last := 0, 0
+	for _, c := range p {
+		s := c.Sign()
+		if s == 0 {
+			continue
+		}
+		if last != 0 && s != last {
+			v++
+		}
+		last = s
+	}
+	return v
+}
+
+// DescartesBounds() returns the sign variations of P(x) and P(-x)
+// By Descartes' rule of signs, the number of positive (negative) real roots counted with multiplicity
+// is pos (neg) minus a non-negative even number; the root 0 is not counted
+func (p Poly) DescartesBounds() (pos, neg int) {
+	q := p.Clone(0)
+	for i := 1; i < len(q); i += 2 {
+		q[i].Neg(q[i])
+	}
+	return p.SignVariations(), q.SignVariations()
+}
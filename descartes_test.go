@@ -0,0 +1,29 @@
+package polynomial
+
+import "testing"
+
+func TestDescartesBounds(t *testing.T) {
+	cases := []struct {
+		p        Poly
+		pos, neg int
+	}{
+		{Wilkinson(3), 3, 0},
+		{NewPolyInts(1, -3, 0, 1), 2, 1},
+		// x^2 + 1 has no sign variation in P(x) or P(-x), so no real roots
+		{NewPolyInts(1, 0, 1), 0, 0},
+		{NewPolyInts(-1, 1, 1), 1, 1},
+		// x^3 - x = x(x - 1)(x + 1): the root 0 is not counted
+		{NewPolyInts(0, -1, 0, 1), 1, 1},
+		{NewPolyInts(5), 0, 0},
+		{NewPolyInts(1, -1, 1, -1, 1), 4, 0},
+	}
+	for _, c := range cases {
+		pos, neg := c.p.DescartesBounds()
+		if pos != c.pos || neg != c.neg {
+			t.Errorf("DescartesBounds(%v) = (%d, %d), want (%d, %d)", c.p, pos, neg, c.pos, c.neg)
+		}
+		if v := c.p.SignVariations(); v != c.pos {
+			t.Errorf("SignVariations(%v) = %d, want %d", c.p, v, c.pos)
+		}
+	}
+}
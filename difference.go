@@ -0,0 +1,99 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errNotIntegral = errors.New("polynomial: the coefficients are not integers (or not invertible modulo m)")
+
+// Difference() returns the forward difference P(x + 1) - P(x)
+// modulo m can be nil
+func (p Poly) Difference(m *big.Int) Poly {
+	return p.Compose(NewPolyInts(1, 1), m).Sub(p, m)
+}
+
+// ForwardDifferences() returns the top row of the difference table: P(0), dP(0), d^2P(0), ..., d^nP(0)
+// These are the coefficients of P in the binomial basis, P(x) = sum d^kP(0) C(x, k)
+// modulo m can be nil
+func (p Poly) ForwardDifferences(m *big.Int) []*big.Int {
+	n := p.GetDegree()
+	row := make([]*big.Int, n+1)
+	for i := range row {
+		row[i] = p.Eval(big.NewInt(int64(i)), m)
+	}
+	top := make([]*big.Int, n+1)
+	for k := 0; k <= n; k++ {
+		top[k] = new(big.Int).Set(row[0])
+		for i := 0; i < n-k; i++ {
+			row[i].Sub(row[i+1], row[i])
+			if m != nil {
+				row[i].Mod(row[i], m)
+			}
+		}
+	}
+	return top
+}
+
+// FromBinomialBasis() returns sum c_k C(x, k), the inverse of ForwardDifferences()
+// Over Z it fails if the result does not have integer coefficients, like C(x, 2) = (x^2 - x) / 2
+// Modulo m it fails if k! is not invertible for some k
+func FromBinomialBasis(c []*big.Int, m *big.Int) (Poly, error) {
+	sum := RatPoly{new(big.Rat)}
+	basis := RatPoly{big.NewRat(1, 1)}
+	for k, ck := range c {
+		if k > 0 {
+			// C(x, k) = C(x, k-1) (x - k + 1) / k
+			basis = basis.Mul(NewRatPolyFracs(int64(1-k), int64(k), 1, int64(k)))
+		}
+		term := make(RatPoly, len(basis))
+		for i, b := range basis {
+			term[i] = new(big.Rat).Mul(b, new(big.Rat).SetInt(ck))
+		}
+		sum = sum.Add(term)
+	}
+	p := make(Poly, len(sum))
+	for i, s := range sum {
+		v, ok := ratMod(s, m)
+		if !ok {
+			return nil, errNotIntegral
+		}
+		p[i] = v
+	}
+	p.trim()
+	return p, nil
+}
+
+// ratMod() maps a rational number to an integer, modulo m when m is not nil
+func ratMod(x *big.Rat, m *big.Int) (*big.Int, bool) {
+	if m == nil {
+		if !x.IsInt() {
+			return nil, false
+		}
+		return new(big.Int).Set(x.Num()), true
+	}
+	inv := new(big.Int).ModInverse(x.Denom(), m)
+	if inv == nil {
+		return nil, false
+	}
+	return inv.Mul(inv, x.Num()).Mod(inv, m), true
+}
+
+// EvalRange() returns P(0), P(1), ..., P(n-1)
+// After deg P + 1 evaluations, the difference table is extended with additions only
+// modulo m can be nil
+func (p Poly) EvalRange(n int, m *big.Int) []*big.Int {
+	diffs := p.ForwardDifferences(m)
+	d := len(diffs) - 1
+	ys := make([]*big.Int, n)
+	for i := range ys {
+		ys[i] = new(big.Int).Set(diffs[0])
+		for k := 0; k < d; k++ {
+			diffs[k].Add(diffs[k], diffs[k+1])
+			if m != nil {
+				diffs[k].Mod(diffs[k], m)
+			}
+		}
+	}
+	return ys
+}
@@ -0,0 +1,77 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDifference(t *testing.T) {
+	// d(x^3) = 3x^2 + 3x + 1
+	got := NewPolyInts(0, 0, 0, 1).Difference(nil)
+	if want := NewPolyInts(1, 3, 3); got.Compare(&want) != 0 {
+		t.Errorf("Difference(x^3) = %v, want %v", got, want)
+	}
+}
+
+func TestBinomialBasis(t *testing.T) {
+	m := big.NewInt(101)
+	cases := []struct {
+		p     Poly
+		m     *big.Int
+		diffs []*big.Int
+	}{
+		{NewPolyInts(0, 0, 1), nil, bigs(0, 1, 2)},
+		{NewPolyInts(1, -2, 0, 1), nil, bigs(1, -1, 6, 6)},
+		{NewPolyInts(5), nil, bigs(5)},
+		{NewPolyInts(0, 0, 0, 1), m, bigs(0, 1, 6, 6)},
+		{NewPolyInts(3, 0, 100), m, bigs(3, 100, 99)},
+	}
+	for _, c := range cases {
+		diffs := c.p.ForwardDifferences(c.m)
+		if len(diffs) != len(c.diffs) {
+			t.Errorf("ForwardDifferences(%v) = %v, want %v", c.p, diffs, c.diffs)
+			continue
+		}
+		for i := range diffs {
+			if diffs[i].Cmp(c.diffs[i]) != 0 {
+				t.Errorf("ForwardDifferences(%v) = %v, want %v", c.p, diffs, c.diffs)
+				break
+			}
+		}
+		q, err := FromBinomialBasis(diffs, c.m)
+		if err != nil || q.Compare(&c.p) != 0 {
+			t.Errorf("FromBinomialBasis(%v) = %v, want %v (error: %v)", diffs, q, c.p, err)
+		}
+	}
+
+	// C(x, 2) is integer-valued but its coefficients are not integers
+	if _, err := FromBinomialBasis(bigs(0, 0, 1), nil); err == nil {
+		t.Errorf("C(x, 2) should not have integer coefficients")
+	}
+	got, err := FromBinomialBasis(bigs(0, 0, 1), m)
+	if want := NewPolyInts(0, 50, 51); err != nil || got.Compare(&want) != 0 {
+		t.Errorf("C(x, 2) mod %v = %v, want %v (error: %v)", m, got, want, err)
+	}
+	if _, err := FromBinomialBasis(bigs(0, 0, 1), big.NewInt(2)); err == nil {
+		t.Errorf("2! is not invertible modulo 2")
+	}
+}
+
+func TestEvalRange(t *testing.T) {
+	cases := []struct {
+		p Poly
+		m *big.Int
+	}{
+		{NewPolyInts(7, -3, 0, 2), nil},
+		{NewPolyInts(4), nil},
+		{NewPolyInts(1, 2, 3, 4, 5), big.NewInt(13)},
+	}
+	for _, c := range cases {
+		ys := c.p.EvalRange(20, c.m)
+		for i, y := range ys {
+			if want := c.p.Eval(big.NewInt(int64(i)), c.m); y.Cmp(want) != 0 {
+				t.Errorf("EvalRange(%v)[%d] = %v, want %v", c.p, i, y, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package polynomial
+
+import "math/big"
+
+// constMulti() returns the constant c as a polynomial in nvars variables
+func constMulti(nvars int, c int64) MultiPoly {
+	return NewMultiPoly(nvars, MonoTerm{make([]int, nvars), big.NewInt(c)})
+}
+
+// CoeffsIn() writes P = sum C_i v^i and returns C_0, C_1, ..., C_d for d = P.DegreeIn(v)
+// The C_i keep NVars variables but do not depend on v
+func (p MultiPoly) CoeffsIn(v int) []MultiPoly {
+	accs := make([]*multiAcc, p.DegreeIn(v)+1)
+	for i := range accs {
+		accs[i] = newMultiAcc(p.NVars)
+	}
+	e := make([]int, p.NVars)
+	for _, t := range p.Terms {
+		copy(e, t.Exp)
+		e[v] = 0
+		accs[t.Exp[v]].add(e, t.Coeff)
+	}
+	cs := make([]MultiPoly, len(accs))
+	for i, a := range accs {
+		cs[i] = a.result(nil)
+	}
+	return cs
+}
+
+// multiDet() returns the determinant of a square matrix of polynomials without any division (Berkowitz)
+// With A_k the leading k x k block, R its next row, C its next column and a = A[k][k], the characteristic
+// polynomials satisfy c_(k+1) = T c_k for the lower triangular Toeplitz matrix with first column
+// (1, -a, -R C, -R A_k C, ..., -R A_k^(k-1) C)
+func multiDet(a [][]MultiPoly, nvars int, m *big.Int) MultiPoly {
+	n := len(a)
+	c := []MultiPoly{constMulti(nvars, 1)}
+	for k := 0; k < n; k++ {
+		t := make([]MultiPoly, k+2)
+		t[0] = constMulti(nvars, 1)
+		t[1] = a[k][k].Neg()
+		v := make([]MultiPoly, k)
+		for i := range v {
+			v[i] = a[i][k]
+		}
+		for j := 0; j < k; j++ {
+			s := MultiPoly{NVars: nvars}
+			for i := range v {
+				s = s.Add(a[k][i].Mul(v[i], m), m)
+			}
+			t[j+2] = s.Neg()
+			w := make([]MultiPoly, k)
+			for r := range w {
+				w[r] = MultiPoly{NVars: nvars}
+				for i := range v {
+					w[r] = w[r].Add(a[r][i].Mul(v[i], m), m)
+				}
+			}
+			v = w
+		}
+		nc := make([]MultiPoly, k+2)
+		for i := range nc {
+			nc[i] = MultiPoly{NVars: nvars}
+			for j := 0; j <= i && j < len(c); j++ {
+				nc[i] = nc[i].Add(t[i-j].Mul(c[j], m), m)
+			}
+		}
+		c = nc
+	}
+	if n%2 == 1 {
+		return c[n].Neg().Add(MultiPoly{NVars: nvars}, m)
+	}
+	return c[n]
+}
+
+// Eliminate() returns the resultant of P and Q with respect to the variable v, the determinant of their Sylvester
+// matrix in v; it vanishes exactly where P and Q have a common root in v (or both leading coefficients vanish),
+// so a system P = Q = 0 in two variables reduces to the univariate roots of the result
+// The result keeps NVars variables but does not depend on v
+// modulo m can be nil
+func Eliminate(p, q MultiPoly, v int, m *big.Int) MultiPoly {
+	if p.IsZero() || q.IsZero() {
+		return MultiPoly{NVars: p.NVars}
+	}
+	pc, qc := p.CoeffsIn(v), q.CoeffsIn(v)
+	dp, dq := len(pc)-1, len(qc)-1
+	n := dp + dq
+	s := make([][]MultiPoly, n)
+	for i := range s {
+		s[i] = make([]MultiPoly, n)
+		for j := range s[i] {
+			s[i][j] = MultiPoly{NVars: p.NVars}
+		}
+	}
+	// dq shifted rows of P's coefficients and dp shifted rows of Q's, leading coefficients first
+	for i := 0; i < dq; i++ {
+		for j := 0; j <= dp; j++ {
+			s[i][i+j] = pc[dp-j]
+		}
+	}
+	for i := 0; i < dp; i++ {
+		for j := 0; j <= dq; j++ {
+			s[dq+i][i+j] = qc[dq-j]
+		}
+	}
+	return multiDet(s, p.NVars, m)
+}
@@ -0,0 +1,65 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCoeffsIn(t *testing.T) {
+	// 3x1^2x2 + x2 + 5x1 = (3x1^2 + 1) x2 + 5x1
+	p := NewMultiPoly(2, mono(3, 2, 1), mono(1, 0, 1), mono(5, 1, 0))
+	cs := p.CoeffsIn(1)
+	want := []MultiPoly{NewMultiPoly(2, mono(5, 1, 0)), NewMultiPoly(2, mono(3, 2, 0), mono(1, 0, 0))}
+	if len(cs) != len(want) {
+		t.Fatalf("CoeffsIn() = %v, expected %v", cs, want)
+	}
+	for i := range want {
+		if !cs[i].Equal(want[i]) {
+			t.Errorf("CoeffsIn()[%d] = %v, expected %v", i, cs[i], want[i])
+		}
+	}
+}
+
+func TestEliminate(t *testing.T) {
+	// the unit circle and the line x2 = x1 meet where 2x1^2 - 1 = 0
+	circle := NewMultiPoly(2, mono(1, 2, 0), mono(1, 0, 2), mono(-1, 0, 0))
+	line := NewMultiPoly(2, mono(1, 0, 1), mono(-1, 1, 0))
+	cases := []struct {
+		p, q MultiPoly
+		v    int
+		m    *big.Int
+		want MultiPoly
+	}{
+		{circle, line, 1, nil, NewMultiPoly(2, mono(2, 2, 0), mono(-1, 0, 0))},
+		{circle, line, 1, big.NewInt(7), NewMultiPoly(2, mono(2, 2, 0), mono(6, 0, 0))},
+		// Res(x2 - 3, x2 - x1) is the second polynomial at the root x2 = 3 of the first
+		{NewMultiPoly(2, mono(1, 0, 1), mono(-3, 0, 0)), line, 1, nil, NewMultiPoly(2, mono(-1, 1, 0), mono(3, 0, 0))},
+		// a factor free of v: Res_v(x1, x2^2 + 1) = x1^2
+		{NewMultiPoly(2, mono(1, 1, 0)), NewMultiPoly(2, mono(1, 0, 2), mono(1, 0, 0)), 1, nil, NewMultiPoly(2, mono(1, 2, 0))},
+		// a common factor (x2 - x1) makes the resultant vanish
+		{line.Mul(circle, nil), line.Mul(NewMultiPoly(2, mono(1, 0, 1), mono(2, 0, 0)), nil), 1, nil, MultiPoly{NVars: 2}},
+	}
+	for _, c := range cases {
+		if got := Eliminate(c.p, c.q, c.v, c.m); !got.Equal(c.want) {
+			t.Errorf("Eliminate(%v, %v, %d) = %v, expected %v", c.p, c.q, c.v, got, c.want)
+		}
+	}
+
+	// two conics mod 13: the roots of the eliminant are exactly the x1 coordinates of the common points
+	m := big.NewInt(13)
+	p := NewMultiPoly(2, mono(1, 2, 0), mono(1, 0, 2), mono(-5, 0, 0))
+	q := NewMultiPoly(2, mono(1, 1, 1), mono(-2, 0, 0))
+	res := Eliminate(p, q, 1, m).ToUnivariate(0, []*big.Int{nil, big.NewInt(0)}, m)
+	for x := int64(0); x < 13; x++ {
+		common := false
+		for y := int64(0); y < 13; y++ {
+			pt := []*big.Int{big.NewInt(x), big.NewInt(y)}
+			if p.Eval(pt, m).Sign() == 0 && q.Eval(pt, m).Sign() == 0 {
+				common = true
+			}
+		}
+		if root := res.Eval(big.NewInt(x), m).Sign() == 0; root != common {
+			t.Errorf("x1 = %d: eliminant root %v, common point %v", x, root, common)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestEvaluator(t *testing.T) {
+	m := big.NewInt(998244353)
+	cases := []struct {
+		p Poly
+		m *big.Int
+	}{
+		{NewPolyInts(0), m},
+		{NewPolyInts(5), nil},
+		{NewPolyInts(1, -2, 3), nil},
+		{NewPolyInts(1, -2, 3, 0, 0, 7), big.NewInt(11)},
+		{RandomPoly(99, 64), m},
+		{RandomPoly(100, 64), m},
+		{RandomPoly(30, 16), nil},
+	}
+	xs := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(-3), big.NewInt(12345), RandomBigInt(8)}
+	for _, c := range cases {
+		e := c.p.NewEvaluator(c.m)
+		got := e.EvalAll(xs)
+		for i, x := range xs {
+			want := c.p.Eval(x, c.m)
+			if c.m != nil {
+				want.Mod(want, c.m)
+			}
+			if got[i].Cmp(want) != 0 {
+				t.Errorf("Evaluator of degree %d at %v = %v, expected %v", c.p.GetDegree(), x, got[i], want)
+			}
+		}
+	}
+}
+
+func BenchmarkEvaluator(b *testing.B) {
+	m := big.NewInt(998244353)
+	p := RandomPoly(1023, 30)
+	e := p.NewEvaluator(m)
+	x := big.NewInt(123456789)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.Eval(x)
+	}
+}
+
+func BenchmarkEvalHorner(b *testing.B) {
+	m := big.NewInt(998244353)
+	p := RandomPoly(1023, 30)
+	p.sanitize(m)
+	x := big.NewInt(123456789)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Eval(x, m)
+	}
+}
@@ -0,0 +1,170 @@
+package polynomial
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"sort"
+)
+
+var errFactorModulus = errors.New("polynomial: factorization needs a prime modulus")
+
+// Factor() returns the monic irreducible factors of P modulo a prime m with their multiplicities
+// P = lc(P) * F_1^E_1 * ... * F_k^E_k; the factors are sorted by Compare()
+func (p Poly) Factor(m *big.Int) ([]Factor, error) {
+	return p.FactorCtx(context.Background(), m)
+}
+
+// FactorCtx() is Factor() returning ctx.Err() if ctx is done first
+// It runs square-free, distinct-degree and equal-degree (Cantor-Zassenhaus) factorization,
+// checking ctx between their steps
+func (p Poly) FactorCtx(ctx context.Context, m *big.Int) ([]Factor, error) {
+	if m == nil || m.Sign() <= 0 || !m.ProbablyPrime(20) {
+		return nil, errFactorModulus
+	}
+	f, err := p.Monic(m)
+	if err != nil {
+		return nil, err
+	}
+	sqf, err := squarefreeFactors(ctx, f, 1, m)
+	if err != nil {
+		return nil, err
+	}
+	var fs []Factor
+	for _, s := range sqf {
+		dd, err := distinctDegree(ctx, s.P, m)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range dd {
+			irr, err := equalDegree(ctx, g.P, g.E, m)
+			if err != nil {
+				return nil, err
+			}
+			for _, h := range irr {
+				fs = append(fs, Factor{h, s.E})
+			}
+		}
+	}
+	sort.Slice(fs, func(i, j int) bool { return fs[i].P.Compare(&fs[j].P) < 0 })
+	return fs, nil
+}
+
+// pthRoot() returns G with G(x)^p = F(x) for F a polynomial in x^p modulo the prime p
+func pthRoot(f Poly, p int) Poly {
+	r := make(Poly, f.GetDegree()/p+1)
+	for i := range r {
+		r[i] = new(big.Int).Set(f[i*p])
+	}
+	return r
+}
+
+// squarefreeFactors() returns the square-free parts of a monic F with multiplicities scaled by k (Yun's algorithm
+// extended to characteristic m)
+func squarefreeFactors(ctx context.Context, f Poly, k int, m *big.Int) ([]Factor, error) {
+	if f.GetDegree() < 1 {
+		return nil, nil
+	}
+	d := f.Derivative(m)
+	if d.isZero() {
+		// F is a polynomial in x^m, so m is at most deg F and fits an int
+		return squarefreeFactors(ctx, pthRoot(f, int(m.Int64())), k*int(m.Int64()), m)
+	}
+	var fs []Factor
+	c := normalGcd(f, d, m)
+	w := normalQuo(f, c, m)
+	for i := 1; w.GetDegree() > 0; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		y := normalGcd(w, c, m)
+		if g := normalQuo(w, y, m); g.GetDegree() > 0 {
+			fs = append(fs, Factor{g, i * k})
+		}
+		w = y
+		c = normalQuo(c, y, m)
+	}
+	if c.GetDegree() > 0 {
+		rest, err := squarefreeFactors(ctx, pthRoot(c, int(m.Int64())), k*int(m.Int64()), m)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, rest...)
+	}
+	return fs, nil
+}
+
+// distinctDegree() splits a monic square-free F into products of irreducibles of equal degree
+// Each Factor holds the product in P and the common degree in E
+func distinctDegree(ctx context.Context, f Poly, m *big.Int) ([]Factor, error) {
+	var fs []Factor
+	x := NewPolyInts(0, 1)
+	_, h := x.Clone(0).Div(f.Clone(0), m)
+	for d := 1; 2*d <= f.GetDegree(); d++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// h = x^(m^d) mod F
+		h = h.PowMod(m, f, m)
+		if g := normalGcd(f, h.Sub(x, m), m); g.GetDegree() > 0 {
+			fs = append(fs, Factor{g, d})
+			f = normalQuo(f, g, m)
+			_, h = h.Div(f.Clone(0), m)
+		}
+	}
+	if f.GetDegree() > 0 {
+		fs = append(fs, Factor{f, f.GetDegree()})
+	}
+	return fs, nil
+}
+
+// equalDegree() splits a monic square-free F whose irreducible factors all have degree d (Cantor-Zassenhaus)
+func equalDegree(ctx context.Context, f Poly, d int, m *big.Int) ([]Poly, error) {
+	n := f.GetDegree()
+	if n <= d {
+		return []Poly{f}, nil
+	}
+	// e = (m^d - 1) / 2 for odd m
+	e := new(big.Int).Exp(m, big.NewInt(int64(d)), nil)
+	e.Sub(e, big.NewInt(1))
+	e.Rsh(e, 1)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		a := make(Poly, n)
+		for i := range a {
+			a[i], _ = rand.Int(rand.Reader, m)
+		}
+		a.trim()
+		if a.GetDegree() < 1 {
+			continue
+		}
+		var b Poly
+		if m.Cmp(big.NewInt(2)) == 0 {
+			// the trace a + a^2 + ... + a^(2^(d-1)) lies in F_2 on every factor
+			b = a.Clone(0)
+			t := a.Clone(0)
+			for i := 1; i < d; i++ {
+				_, t = t.Clone(0).Mul(t, m).Div(f.Clone(0), m)
+				b = b.Add(t, m)
+			}
+		} else {
+			b = a.PowMod(e, f, m).Sub(NewPolyInts(1), m)
+		}
+		g := normalGcd(f, b, m)
+		if g.GetDegree() < 1 || g.GetDegree() >= n {
+			continue
+		}
+		l, err := equalDegree(ctx, g, d, m)
+		if err != nil {
+			return nil, err
+		}
+		r, err := equalDegree(ctx, normalQuo(f, g, m), d, m)
+		if err != nil {
+			return nil, err
+		}
+		return append(l, r...), nil
+	}
+}
@@ -0,0 +1,96 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFactor(t *testing.T) {
+	cases := []struct {
+		p    Poly
+		m    int64
+		want []Factor
+	}{
+		// x^4 - 1 = (x - 1)(x - 2)(x - 3)(x - 4) mod 5
+		{NewPolyInts(-1, 0, 0, 0, 1), 5, []Factor{
+			{NewPolyInts(1, 1), 1}, {NewPolyInts(2, 1), 1}, {NewPolyInts(3, 1), 1}, {NewPolyInts(4, 1), 1},
+		}},
+		// x^4 + x = x (x + 1)(x^2 + x + 1) mod 2
+		{NewPolyInts(0, 1, 0, 0, 1), 2, []Factor{
+			{NewPolyInts(0, 1), 1}, {NewPolyInts(1, 1), 1}, {NewPolyInts(1, 1, 1), 1},
+		}},
+		// 2x^3 + 1 = 2 (x^3 - 1) = 2 (x - 1)^3 mod 3
+		{NewPolyInts(1, 0, 0, 2), 3, []Factor{{NewPolyInts(2, 1), 3}}},
+		// (x^2 + 1)^2 (x + 1)^3 mod 3
+		{NewPolyInts(1, 1).Mul(NewPolyInts(1, 1), nil).Mul(NewPolyInts(1, 1), nil).
+			Mul(NewPolyInts(1, 0, 1), nil).Mul(NewPolyInts(1, 0, 1), nil), 3, []Factor{
+			{NewPolyInts(1, 1), 3}, {NewPolyInts(1, 0, 1), 2},
+		}},
+		// x^2 + 1 is irreducible mod 7
+		{NewPolyInts(1, 0, 1), 7, []Factor{{NewPolyInts(1, 0, 1), 1}}},
+		{NewPolyInts(3), 7, nil},
+	}
+	for _, c := range cases {
+		got, err := c.p.Clone(0).Factor(big.NewInt(c.m))
+		if err != nil {
+			t.Errorf("%v mod %d: %v", c.p, c.m, err)
+			continue
+		}
+		if len(got) != len(c.want) {
+			t.Errorf("%v mod %d factors as %v, expected %v", c.p, c.m, got, c.want)
+			continue
+		}
+		for i := range got {
+			if !got[i].P.Equal(c.want[i].P) || got[i].E != c.want[i].E {
+				t.Errorf("%v mod %d factors as %v, expected %v", c.p, c.m, got, c.want)
+				break
+			}
+		}
+	}
+}
+
+func TestFactorProduct(t *testing.T) {
+	for _, mi := range []int64{2, 3, 13, 1000003} {
+		m := big.NewInt(mi)
+		for i := 0; i < 5; i++ {
+			p := RandomPoly(12, 16)
+			// repeat a factor so the square-free step has work to do
+			p = p.Mul(NewPolyInts(1, 2, 1), m)
+			p.trim()
+			fs, err := p.Clone(0).Factor(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			prod := Poly{new(big.Int).Mod(p.LeadingCoeff(), m)}
+			for _, f := range fs {
+				if f.P.GetDegree() < 1 || !f.P.IsMonic(m) {
+					t.Errorf("factor %v of %v mod %d is not monic and non-constant", f.P, p, mi)
+				}
+				// x^(m^d) = x mod an irreducible factor of degree d
+				x := NewPolyInts(0, 1)
+				e := new(big.Int).Exp(m, big.NewInt(int64(f.P.GetDegree())), nil)
+				if f.P.GetDegree() > 1 && !x.PowMod(e, f.P, m).Equal(x) {
+					t.Errorf("factor %v of %v mod %d is not irreducible", f.P, p, mi)
+				}
+				for j := 0; j < f.E; j++ {
+					prod = prod.Mul(f.P.Clone(0), m)
+				}
+			}
+			if !prod.Equal(p) {
+				t.Errorf("factors %v of %v mod %d multiply to %v", fs, p, mi, prod)
+			}
+		}
+	}
+}
+
+func TestFactorErrors(t *testing.T) {
+	cases := []*big.Int{nil, big.NewInt(0), big.NewInt(15)}
+	for _, m := range cases {
+		if _, err := NewPolyInts(1, 1).Factor(m); err != errFactorModulus {
+			t.Errorf("Factor() modulo %v: error %v, expected errFactorModulus", m, err)
+		}
+	}
+	if _, err := NewPolyInts(0).Factor(big.NewInt(7)); err != errZeroPoly {
+		t.Errorf("Factor(0): error %v, expected errZeroPoly", err)
+	}
+}
@@ -0,0 +1,23 @@
+package polynomial
+
+import "math/big"
+
+// FibonacciPoly() returns the Fibonacci polynomial F_n, with F_n(1) the n-th Fibonacci number
+// F_0 = 0, F_1 = 1, F_(k+1) = x F_k + F_(k-1)
+// modulo m can be nil
+func FibonacciPoly(n int, m *big.Int) Poly {
+	return recur(n, NewPolyInts(0), NewPolyInts(1), func(_ int, prev, cur Poly) Poly {
+		return cur.Clone(1).Add(prev, m)
+	})
+}
+
+// LucasPoly() returns the Lucas polynomial L_n, with L_n(1) the n-th Lucas number
+// L_0 = 2, L_1 = x, L_(k+1) = x L_k + L_(k-1)
+// modulo m can be nil
+func LucasPoly(n int, m *big.Int) Poly {
+	p0 := NewPolyInts(2)
+	p0.sanitize(m)
+	return recur(n, p0, NewPolyInts(0, 1), func(_ int, prev, cur Poly) Poly {
+		return cur.Clone(1).Add(prev, m)
+	})
+}
@@ -0,0 +1,52 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFibonacciLucasPoly(t *testing.T) {
+	cases := []struct {
+		n    int
+		f, l Poly
+	}{
+		{0, NewPolyInts(0), NewPolyInts(2)},
+		{1, NewPolyInts(1), NewPolyInts(0, 1)},
+		{2, NewPolyInts(0, 1), NewPolyInts(2, 0, 1)},
+		{3, NewPolyInts(1, 0, 1), NewPolyInts(0, 3, 0, 1)},
+		{5, NewPolyInts(1, 0, 3, 0, 1), NewPolyInts(0, 5, 0, 5, 0, 1)},
+	}
+	for _, c := range cases {
+		if got := FibonacciPoly(c.n, nil); got.Compare(&c.f) != 0 {
+			t.Errorf("FibonacciPoly(%d) = %v, want %v", c.n, got, c.f)
+		}
+		if got := LucasPoly(c.n, nil); got.Compare(&c.l) != 0 {
+			t.Errorf("LucasPoly(%d) = %v, want %v", c.n, got, c.l)
+		}
+	}
+
+	// L_n = F_(n-1) + F_(n+1) and F_n(1), L_n(1) are the Fibonacci and Lucas numbers
+	one := big.NewInt(1)
+	for n := 1; n < 20; n++ {
+		sum := FibonacciPoly(n-1, nil).Add(FibonacciPoly(n+1, nil), nil)
+		if l := LucasPoly(n, nil); sum.Compare(&l) != 0 {
+			t.Errorf("F_%d + F_%d = %v, want %v", n-1, n+1, sum, l)
+		}
+	}
+	if got := FibonacciPoly(30, nil).Eval(one, nil); got.Int64() != 832040 {
+		t.Errorf("F_30(1) = %v, want 832040", got)
+	}
+	if got := LucasPoly(20, nil).Eval(one, nil); got.Int64() != 15127 {
+		t.Errorf("L_20(1) = %v, want 15127", got)
+	}
+
+	m := big.NewInt(5)
+	want := FibonacciPoly(12, nil)
+	want.sanitize(m)
+	if got := FibonacciPoly(12, m); got.Compare(&want) != 0 {
+		t.Errorf("FibonacciPoly(12) mod 5 = %v, want %v", got, want)
+	}
+	if got := LucasPoly(0, big.NewInt(2)); !got.isZero() {
+		t.Errorf("LucasPoly(0) mod 2 = %v, want 0", got)
+	}
+}
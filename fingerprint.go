@@ -0,0 +1,40 @@
+package polynomial
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"math/big"
+)
+
+// Hash() writes the canonical encoding of P into h
+// The encoding is the tag "poly", the degree as a 4-byte big-endian integer and every
+// coefficient from the constant term up as a sign byte, a 4-byte length and the magnitude
+// P is normalized first, so untrimmed or nil coefficients do not change the digest
+func (p Poly) Hash(h hash.Hash) {
+	q := make(Poly, len(p))
+	for i, c := range p {
+		if c != nil {
+			q[i] = new(big.Int).Set(c)
+		}
+	}
+	q.Normalize(nil)
+	buf := []byte("poly")
+	var d [4]byte
+	binary.BigEndian.PutUint32(d[:], uint32(q.GetDegree()))
+	buf = append(buf, d[:]...)
+	for _, c := range q {
+		buf = appendBigInt(buf, c)
+	}
+	h.Write(buf)
+}
+
+// Fingerprint() returns the SHA-256 digest of the canonical encoding (see Hash())
+// Equal polynomials have equal fingerprints, so it can be used as a map key
+func (p Poly) Fingerprint() [sha256.Size]byte {
+	h := sha256.New()
+	p.Hash(h)
+	var f [sha256.Size]byte
+	copy(f[:], h.Sum(nil))
+	return f
+}
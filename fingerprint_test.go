@@ -0,0 +1,47 @@
+package polynomial
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	a := NewPolyInts(1, 2, 3)
+	same := []Poly{
+		NewPolyInts(1, 2, 3),
+		{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(0)},
+	}
+	for _, b := range same {
+		if a.Fingerprint() != b.Fingerprint() {
+			t.Errorf("%v and %#v should have the same fingerprint", a, b)
+		}
+	}
+	different := []Poly{
+		NewPolyInts(1, 2, -3),
+		NewPolyInts(1, 2, 3, 1),
+		NewPolyInts(3, 2, 1),
+		NewPolyInts(0, 1, 2, 3),
+		// the coefficient boundaries are part of the encoding
+		{big.NewInt(0x0102), big.NewInt(3)},
+		{big.NewInt(1), big.NewInt(0x0203)},
+	}
+	seen := map[[sha256.Size]byte]Poly{a.Fingerprint(): a}
+	for _, b := range different {
+		f := b.Fingerprint()
+		if c, ok := seen[f]; ok {
+			t.Errorf("%v and %v have the same fingerprint", b, c)
+		}
+		seen[f] = b
+	}
+	if z := (Poly{nil}); z.Fingerprint() != NewPolyInts(0).Fingerprint() {
+		t.Errorf("a nil coefficient should hash like zero")
+	}
+	h := sha256.New()
+	a.Hash(h)
+	var f [sha256.Size]byte
+	copy(f[:], h.Sum(nil))
+	if f != a.Fingerprint() {
+		t.Errorf("Fingerprint() should be the SHA-256 digest of Hash()")
+	}
+}
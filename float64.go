@@ -0,0 +1,57 @@
+package polynomial
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+var (
+	errFloatOverflow  = errors.New("polynomial: a coefficient does not fit in a float64")
+	errFloatNotFinite = errors.New("polynomial: a coefficient is NaN or infinite")
+)
+
+// ToFloat64s() returns the coefficients as float64, lowest degree first like Poly (and like gonum's []float64 vectors)
+// The accuracy is big.Exact unless some coefficient was rounded, otherwise the direction of the last rounding
+// It fails if a coefficient is out of the float64 range
+func (p Poly) ToFloat64s() ([]float64, big.Accuracy, error) {
+	fs := make([]float64, len(p))
+	acc := big.Exact
+	for i, c := range p {
+		f, a := new(big.Float).SetInt(c).Float64()
+		if math.IsInf(f, 0) {
+			return nil, a, errFloatOverflow
+		}
+		if a != big.Exact {
+			acc = a
+		}
+		fs[i] = f
+	}
+	return fs, acc, nil
+}
+
+// FromFloat64s() rounds every float64 to the nearest integer (halves away from zero)
+// The accuracy is big.Exact if every value was already an integer, otherwise the direction of the last rounding
+// It fails on NaN and infinities
+func FromFloat64s(fs []float64) (Poly, big.Accuracy, error) {
+	p := make(Poly, len(fs))
+	acc := big.Exact
+	for i, f := range fs {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, acc, errFloatNotFinite
+		}
+		r := math.Round(f)
+		switch {
+		case r < f:
+			acc = big.Below
+		case r > f:
+			acc = big.Above
+		}
+		p[i], _ = new(big.Float).SetFloat64(r).Int(nil)
+	}
+	if len(p) == 0 {
+		return NewPolyInts(0), acc, nil
+	}
+	p.trim()
+	return p, acc, nil
+}
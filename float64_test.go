@@ -0,0 +1,60 @@
+package polynomial
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestToFloat64s(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(1), 1100)
+	odd := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 60), big.NewInt(1))
+	cases := []struct {
+		p    Poly
+		want []float64
+		acc  big.Accuracy
+		err  bool
+	}{
+		{NewPolyInts(1, -2, 3), []float64{1, -2, 3}, big.Exact, false},
+		{Poly{odd}, []float64{1 << 60}, big.Below, false},
+		{Poly{big.NewInt(1), huge}, nil, big.Above, true},
+	}
+	for _, c := range cases {
+		got, acc, err := c.p.ToFloat64s()
+		if (err != nil) != c.err || acc != c.acc {
+			t.Errorf("ToFloat64s(%v) = %v, %v, %v", c.p, got, acc, err)
+			continue
+		}
+		for i := range c.want {
+			if got[i] != c.want[i] {
+				t.Errorf("ToFloat64s(%v) = %v, want %v", c.p, got, c.want)
+				break
+			}
+		}
+	}
+}
+
+func TestFromFloat64s(t *testing.T) {
+	cases := []struct {
+		fs   []float64
+		want Poly
+		acc  big.Accuracy
+		err  bool
+	}{
+		{[]float64{1, -2, 3}, NewPolyInts(1, -2, 3), big.Exact, false},
+		{[]float64{0.4, 2.5, 0}, NewPolyInts(0, 3), big.Above, false},
+		{[]float64{-1.2}, NewPolyInts(-1), big.Above, false},
+		{[]float64{1.7}, NewPolyInts(2), big.Above, false},
+		{[]float64{1.2}, NewPolyInts(1), big.Below, false},
+		{[]float64{1e20}, Poly{new(big.Int).Mul(big.NewInt(100000000000), big.NewInt(1000000000))}, big.Exact, false},
+		{[]float64{}, NewPolyInts(0), big.Exact, false},
+		{[]float64{math.NaN()}, nil, big.Exact, true},
+		{[]float64{1, math.Inf(-1)}, nil, big.Exact, true},
+	}
+	for _, c := range cases {
+		got, acc, err := FromFloat64s(c.fs)
+		if (err != nil) != c.err || acc != c.acc || (err == nil && got.Compare(&c.want) != 0) {
+			t.Errorf("FromFloat64s(%v) = %v, %v, %v, want %v, %v", c.fs, got, acc, err, c.want, c.acc)
+		}
+	}
+}
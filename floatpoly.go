@@ -0,0 +1,158 @@
+package polynomial
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Data structure for a polynomial with big.Float coefficients
+// Same layout as Poly; every coefficient carries its own precision (see NewFloatPoly)
+// It is meant for numeric prototyping before moving to exact arithmetic
+type FloatPoly []*big.Float
+
+// NewFloatPoly() converts P with prec bits of mantissa
+// The accuracy is big.Exact unless some coefficient had to be rounded,
+// otherwise it reports the direction of the last rounding
+func NewFloatPoly(p Poly, prec uint) (FloatPoly, big.Accuracy) {
+	f := make(FloatPoly, len(p))
+	acc := big.Exact
+	for i, c := range p {
+		f[i] = new(big.Float).SetPrec(prec).SetInt(c)
+		if a := f[i].Acc(); a != big.Exact {
+			acc = a
+		}
+	}
+	return f, acc
+}
+
+// NewFloatPolyFloats() builds a polynomial from float64 coefficients
+func NewFloatPolyFloats(prec uint, coeffs ...float64) FloatPoly {
+	f := make(FloatPoly, len(coeffs))
+	for i, c := range coeffs {
+		f[i] = new(big.Float).SetPrec(prec).SetFloat64(c)
+	}
+	return f
+}
+
+// returns the degree (leading zeros are counted, as FloatPoly is never trimmed automatically)
+func (f FloatPoly) GetDegree() int {
+	return len(f) - 1
+}
+
+func (f FloatPoly) prec() uint {
+	var p uint
+	for _, c := range f {
+		if c.Prec() > p {
+			p = c.Prec()
+		}
+	}
+	return p
+}
+
+func (f FloatPoly) String() string {
+	s := "["
+	for i := len(f) - 1; i >= 0; i-- {
+		if i < len(f)-1 {
+			s += " + "
+		}
+		s += f[i].Text('g', 10)
+		if i > 0 {
+			s += "x"
+			if i > 1 {
+				s += fmt.Sprintf("^%d", i)
+			}
+		}
+	}
+	return s + "]"
+}
+
+// Add() adds two polynomials with the larger precision of both
+func (f FloatPoly) Add(g FloatPoly) FloatPoly {
+	prec := f.prec()
+	if g.prec() > prec {
+		prec = g.prec()
+	}
+	if len(f) < len(g) {
+		f, g = g, f
+	}
+	r := make(FloatPoly, len(f))
+	for i := range f {
+		r[i] = new(big.Float).SetPrec(prec).Set(f[i])
+		if i < len(g) {
+			r[i].Add(r[i], g[i])
+		}
+	}
+	return r
+}
+
+// Neg() returns -F
+func (f FloatPoly) Neg() FloatPoly {
+	r := make(FloatPoly, len(f))
+	for i, c := range f {
+		r[i] = new(big.Float).Neg(c)
+	}
+	return r
+}
+
+// Sub() subtracts G from F
+func (f FloatPoly) Sub(g FloatPoly) FloatPoly {
+	return f.Add(g.Neg())
+}
+
+// Mul() multiplies two polynomials with the larger precision of both
+func (f FloatPoly) Mul(g FloatPoly) FloatPoly {
+	prec := f.prec()
+	if g.prec() > prec {
+		prec = g.prec()
+	}
+	r := make(FloatPoly, len(f)+len(g)-1)
+	for i := range r {
+		r[i] = new(big.Float).SetPrec(prec)
+	}
+	t := new(big.Float).SetPrec(prec)
+	for i := range f {
+		for j := range g {
+			r[i+j].Add(r[i+j], t.Mul(f[i], g[j]))
+		}
+	}
+	return r
+}
+
+// Eval() returns F(x) by Horner's rule
+func (f FloatPoly) Eval(x *big.Float) *big.Float {
+	y := new(big.Float).SetPrec(f.prec())
+	for i := len(f) - 1; i >= 0; i-- {
+		y.Mul(y, x)
+		y.Add(y, f[i])
+	}
+	return y
+}
+
+// ToPoly() rounds scale * F to the nearest integer coefficients
+// maxErr is the largest |scale * c - round(scale * c)| over all coefficients,
+// so maxErr == 0 means the conversion was exact
+func (f FloatPoly) ToPoly(scale *big.Int) (p Poly, maxErr *big.Float) {
+	prec := f.prec() + uint(scale.BitLen())
+	s := new(big.Float).SetPrec(prec).SetInt(scale)
+	half := big.NewFloat(0.5)
+	maxErr = new(big.Float).SetPrec(prec)
+	p = make(Poly, len(f))
+	for i, c := range f {
+		v := new(big.Float).SetPrec(prec).Mul(c, s)
+		r := new(big.Float).SetPrec(prec)
+		if v.Sign() < 0 {
+			r.Sub(v, half)
+		} else {
+			r.Add(v, half)
+		}
+		p[i], _ = r.Int(nil) // truncation of v +- 1/2 rounds half away from zero
+		e := new(big.Float).SetPrec(prec).SetInt(p[i])
+		e.Sub(v, e)
+		e.Abs(e)
+		if e.Cmp(maxErr) > 0 {
+			maxErr.Set(e)
+		}
+	}
+	p.trim()
+	return
+}
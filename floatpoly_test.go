@@ -0,0 +1,48 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFloatPolyConversion(t *testing.T) {
+	p := NewPolyInts(5, -4, 3, 3)
+	f, acc := NewFloatPoly(p, 64)
+	if acc != big.Exact {
+		t.Errorf("small integers should convert exactly (accuracy: %v)", acc)
+	}
+	back, e := f.ToPoly(big.NewInt(1))
+	if back.Compare(&p) != 0 || e.Sign() != 0 {
+		t.Errorf("ToPoly(NewFloatPoly(%v)) = %v (error: %v)", p, back, e)
+	}
+
+	huge := Poly{new(big.Int).Lsh(big.NewInt(1), 100)}
+	huge[0].Add(huge[0], big.NewInt(1))
+	if _, acc := NewFloatPoly(huge, 53); acc == big.Exact {
+		t.Errorf("2^100 + 1 cannot be exact with 53 bits")
+	}
+
+	g := NewFloatPolyFloats(64, 0.25, -1.5, 2)
+	q, e := g.ToPoly(big.NewInt(10))
+	if ans := NewPolyInts(3, -15, 20); q.Compare(&ans) != 0 {
+		t.Errorf("10 * %v rounds to %v, want %v", g, q, ans)
+	}
+	if v, _ := e.Float64(); v != 0.5 {
+		t.Errorf("the rounding error of 2.5 should be 0.5 (got %v)", v)
+	}
+}
+
+func TestFloatPolyArithmetic(t *testing.T) {
+	f := NewFloatPolyFloats(64, 1, 1)  // x + 1
+	g := NewFloatPolyFloats(64, -1, 1) // x - 1
+	prod := f.Mul(g)
+	if v, _ := prod.Eval(big.NewFloat(3)).Float64(); v != 8 {
+		t.Errorf("(%v)(%v) at 3 = %v, want 8", f, g, v)
+	}
+	if v, _ := f.Add(g).Eval(big.NewFloat(0.5)).Float64(); v != 1 {
+		t.Errorf("%v + %v at 0.5 = %v, want 1", f, g, v)
+	}
+	if v, _ := f.Sub(g).Eval(big.NewFloat(7)).Float64(); v != 2 {
+		t.Errorf("%v - %v at 7 = %v, want 2", f, g, v)
+	}
+}
@@ -0,0 +1,189 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+// FRIHasher commits to a codeword (usually with a Merkle tree) and opens single positions
+// The hashing scheme is left to the caller
+type FRIHasher interface {
+	Commit(codeword []*big.Int) (root []byte)
+	Open(codeword []*big.Int, index int) (proof [][]byte)
+	Verify(root []byte, index int, value *big.Int, proof [][]byte) bool
+}
+
+// FRILayers holds the successive codewords of FRI commit phase
+// Codewords[0] is the original evaluation vector and every next codeword has half the length
+// Offsets[i] and Gens[i] describe the domain {Offsets[i] * Gens[i]^j} of Codewords[i]
+type FRILayers struct {
+	Codewords  [][]*big.Int
+	Roots      [][]byte
+	Challenges []*big.Int
+	Offsets    []*big.Int
+	Gens       []*big.Int
+}
+
+// FRIOpening is an opening of one layer at position Index and its sibling Index + len/2
+type FRIOpening struct {
+	Index        int
+	Value        *big.Int
+	Sibling      *big.Int
+	ValueProof   [][]byte
+	SiblingProof [][]byte
+}
+
+var (
+	errFRIOddLength     = errors.New("polynomial: FRI codeword length must be even")
+	errFRIInconsistency = errors.New("polynomial: FRI query is inconsistent with the next layer")
+	errFRIBadProof      = errors.New("polynomial: FRI opening proof does not verify")
+	errFRIShape         = errors.New("polynomial: FRI query does not match the number of layers")
+	errFRIIndex         = errors.New("polynomial: FRI opening is not at the sampled query index")
+	errFRIDomain        = errors.New("polynomial: FRI domain offset and generator must be invertible")
+)
+
+// friFoldPair() folds f(x) = a and f(-x) = b into fe(x^2) + beta * fo(x^2)
+// using fe(x^2) = (a + b) / 2 and fo(x^2) = (a - b) / 2x
+func friFoldPair(a, b, x, beta, twoInv, m *big.Int) *big.Int {
+	fe := new(big.Int).Add(a, b)
+	fe.Mul(fe, twoInv)
+	fo := new(big.Int).Sub(a, b)
+	fo.Mul(fo, twoInv)
+	xInv := new(big.Int).ModInverse(x, m)
+	fo.Mul(fo, xInv)
+	fo.Mul(fo, beta)
+	fe.Add(fe, fo)
+	return fe.Mod(fe, m)
+}
+
+// friInvertible() reports whether every point offset * gen^i of the domain has an inverse modulo m
+func friInvertible(offset, gen, m *big.Int) bool {
+	return new(big.Int).ModInverse(offset, m) != nil && new(big.Int).ModInverse(gen, m) != nil
+}
+
+// FRIFold() folds the evaluations of f over {offset * gen^i} with the challenge beta
+// The result has half the length and lives on the domain {offset^2 * (gen^2)^i}
+// gen must generate a multiplicative subgroup of order len(evals) modulo the prime m, and offset must be nonzero
+func FRIFold(evals []*big.Int, offset, gen, beta, m *big.Int) ([]*big.Int, error) {
+	n := len(evals)
+	if n%2 != 0 {
+		return nil, errFRIOddLength
+	}
+	if !friInvertible(offset, gen, m) {
+		return nil, errFRIDomain
+	}
+	twoInv := new(big.Int).ModInverse(big.NewInt(2), m)
+	half := n / 2
+	out := make([]*big.Int, half)
+	x := new(big.Int).Mod(offset, m)
+	for j := 0; j < half; j++ {
+		out[j] = friFoldPair(evals[j], evals[j+half], x, beta, twoInv, m)
+		x.Mul(x, gen)
+		x.Mod(x, m)
+	}
+	return out, nil
+}
+
+// BuildFRILayers() runs the commit phase of FRI for the given number of rounds
+// challenge() receives the root of the latest codeword and returns the folding challenge
+// so that challenges can be derived by Fiat-Shamir
+func BuildFRILayers(evals []*big.Int, offset, gen *big.Int, rounds int, h FRIHasher, challenge func(root []byte) *big.Int, m *big.Int) (*FRILayers, error) {
+	l := &FRILayers{}
+	cw := evals
+	off := new(big.Int).Mod(offset, m)
+	g := new(big.Int).Mod(gen, m)
+	for i := 0; ; i++ {
+		l.Codewords = append(l.Codewords, cw)
+		l.Offsets = append(l.Offsets, off)
+		l.Gens = append(l.Gens, g)
+		root := h.Commit(cw)
+		l.Roots = append(l.Roots, root)
+		if i == rounds {
+			break
+		}
+		beta := challenge(root)
+		l.Challenges = append(l.Challenges, beta)
+		next, err := FRIFold(cw, off, g, beta, m)
+		if err != nil {
+			return nil, err
+		}
+		cw = next
+		off = new(big.Int).Mul(off, off)
+		off.Mod(off, m)
+		g = new(big.Int).Mul(g, g)
+		g.Mod(g, m)
+	}
+	return l, nil
+}
+
+// Query() opens every layer (except the last one) at the position derived from index
+func (l *FRILayers) Query(index int, h FRIHasher) []FRIOpening {
+	openings := make([]FRIOpening, len(l.Codewords)-1)
+	for i := 0; i < len(openings); i++ {
+		cw := l.Codewords[i]
+		half := len(cw) / 2
+		j := index % half
+		openings[i] = FRIOpening{
+			Index:        j,
+			Value:        cw[j],
+			Sibling:      cw[j+half],
+			ValueProof:   h.Open(cw, j),
+			SiblingProof: h.Open(cw, j+half),
+		}
+	}
+	return openings
+}
+
+// CheckFRIQuery() verifies the openings produced by Query() for the sampled index against roots and challenges
+// Every opening must sit at index modulo half its layer size, so the prover cannot choose the checked positions,
+// and each folded pair must equal the value opened in the next layer
+// The last folded value is returned so that the caller can compare it with the final codeword
+func CheckFRIQuery(roots [][]byte, challenges []*big.Int, offset, gen *big.Int, size, index int, openings []FRIOpening, h FRIHasher, m *big.Int) (*big.Int, error) {
+	if len(openings) != len(challenges) || len(roots) < len(openings) || index < 0 {
+		return nil, errFRIShape
+	}
+	if !friInvertible(offset, gen, m) {
+		return nil, errFRIDomain
+	}
+	twoInv := new(big.Int).ModInverse(big.NewInt(2), m)
+	off := new(big.Int).Mod(offset, m)
+	g := new(big.Int).Mod(gen, m)
+	var folded *big.Int
+	for i, o := range openings {
+		half := size / 2
+		if half == 0 {
+			return nil, errFRIShape
+		}
+		if o.Index != index%half {
+			return nil, errFRIIndex
+		}
+		if !h.Verify(roots[i], o.Index, o.Value, o.ValueProof) || !h.Verify(roots[i], o.Index+half, o.Sibling, o.SiblingProof) {
+			return nil, errFRIBadProof
+		}
+		if folded != nil {
+			// the pair folded in the previous layer lands on either Value or Sibling of this one
+			var want *big.Int
+			switch openings[i-1].Index {
+			case o.Index:
+				want = o.Value
+			case o.Index + half:
+				want = o.Sibling
+			default:
+				return nil, errFRIShape
+			}
+			if folded.Cmp(new(big.Int).Mod(want, m)) != 0 {
+				return nil, errFRIInconsistency
+			}
+		}
+		x := new(big.Int).Exp(g, big.NewInt(int64(o.Index)), m)
+		x.Mul(x, off)
+		x.Mod(x, m)
+		folded = friFoldPair(o.Value, o.Sibling, x, challenges[i], twoInv, m)
+		off.Mul(off, off)
+		off.Mod(off, m)
+		g.Mul(g, g)
+		g.Mod(g, m)
+		size = half
+	}
+	return folded, nil
+}
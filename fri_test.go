@@ -0,0 +1,139 @@
+package polynomial
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+// flatHasher commits to the whole codeword at once and opens a position by revealing everything
+// It is enough to exercise the FRI helpers without a Merkle tree
+type flatHasher struct{}
+
+func (flatHasher) digest(leaves [][]byte) []byte {
+	h := sha256.New()
+	for _, l := range leaves {
+		h.Write(l)
+		h.Write([]byte{0})
+	}
+	return h.Sum(nil)
+}
+
+func (f flatHasher) Commit(cw []*big.Int) []byte {
+	return f.digest(f.Open(cw, 0))
+}
+
+func (flatHasher) Open(cw []*big.Int, index int) [][]byte {
+	leaves := make([][]byte, len(cw))
+	for i, c := range cw {
+		leaves[i] = c.Bytes()
+	}
+	return leaves
+}
+
+func (f flatHasher) Verify(root []byte, index int, v *big.Int, proof [][]byte) bool {
+	if index >= len(proof) || !bytes.Equal(proof[index], v.Bytes()) {
+		return false
+	}
+	return bytes.Equal(root, f.digest(proof))
+}
+
+func friDomain(offset, gen *big.Int, n int, m *big.Int) []*big.Int {
+	xs := make([]*big.Int, n)
+	x := new(big.Int).Set(offset)
+	for i := 0; i < n; i++ {
+		xs[i] = new(big.Int).Set(x)
+		x.Mul(x, gen)
+		x.Mod(x, m)
+	}
+	return xs
+}
+
+func TestFRIFold(t *testing.T) {
+	m := big.NewInt(257)
+	gen := new(big.Int).Exp(big.NewInt(3), big.NewInt(256/16), m) // order 16
+	offset := big.NewInt(5)
+	p := NewPolyInts(3, 1, 4, 1, 5, 9, 2, 6)
+	beta := big.NewInt(77)
+
+	xs := friDomain(offset, gen, 16, m)
+	evals := make([]*big.Int, len(xs))
+	for i, x := range xs {
+		evals[i] = p.Eval(x, m)
+	}
+	folded, err := FRIFold(evals, offset, gen, beta, m)
+	if err != nil {
+		t.Fatalf("FRIFold() failed: %v", err)
+	}
+	// fe + beta * fo where p(x) = fe(x^2) + x * fo(x^2)
+	ans := NewPolyInts(3+77*1, 4+77*1, 5+77*9, 2+77*6)
+	for i := 0; i < 8; i++ {
+		y := new(big.Int).Mul(xs[i], xs[i])
+		want := ans.Eval(y, m)
+		if folded[i].Cmp(want) != 0 {
+			t.Errorf("FRIFold()[%v] = %v, want %v", i, folded[i], want)
+		}
+	}
+
+	if _, err := FRIFold(evals[:3], offset, gen, beta, m); err == nil {
+		t.Errorf("FRIFold() should reject an odd-length codeword")
+	}
+	if _, err := FRIFold(evals, big.NewInt(0), gen, beta, m); err != errFRIDomain {
+		t.Errorf("FRIFold() with offset 0: error %v, expected errFRIDomain", err)
+	}
+}
+
+func TestFRILayersAndQuery(t *testing.T) {
+	m := big.NewInt(257)
+	gen := new(big.Int).Exp(big.NewInt(3), big.NewInt(256/32), m) // order 32
+	offset := big.NewInt(3)
+	p := NewPolyInts(43, 53, 45, 63, 43, 55, 75, 11)
+	xs := friDomain(offset, gen, 32, m)
+	evals := make([]*big.Int, len(xs))
+	for i, x := range xs {
+		evals[i] = p.Eval(x, m)
+	}
+	h := flatHasher{}
+	challenge := func(root []byte) *big.Int {
+		return new(big.Int).Mod(new(big.Int).SetBytes(root), m)
+	}
+	l, err := BuildFRILayers(evals, offset, gen, 3, h, challenge, m)
+	if err != nil {
+		t.Fatalf("BuildFRILayers() failed: %v", err)
+	}
+	if len(l.Codewords) != 4 || len(l.Codewords[3]) != 4 {
+		t.Fatalf("BuildFRILayers() produced %v layers (last one has %v elements)", len(l.Codewords), len(l.Codewords[len(l.Codewords)-1]))
+	}
+	last := l.Codewords[3]
+	for i := 1; i < len(last); i++ {
+		if last[i].Cmp(last[0]) != 0 {
+			t.Errorf("a degree-7 polynomial folded 3 times should be constant (got %v)", last)
+		}
+	}
+
+	for _, index := range []int{0, 5, 13, 31} {
+		openings := l.Query(index, h)
+		v, err := CheckFRIQuery(l.Roots, l.Challenges, offset, gen, 32, index, openings, h, m)
+		if err != nil {
+			t.Errorf("CheckFRIQuery() rejects a valid query at %v: %v", index, err)
+			continue
+		}
+		if v.Cmp(last[openings[len(openings)-1].Index]) != 0 {
+			t.Errorf("CheckFRIQuery() at %v ends with %v, want %v", index, v, last[0])
+		}
+	}
+
+	openings := l.Query(7, h)
+	openings[1].Value = new(big.Int).Add(openings[1].Value, big.NewInt(1))
+	if _, err := CheckFRIQuery(l.Roots, l.Challenges, offset, gen, 32, 7, openings, h, m); err == nil {
+		t.Errorf("CheckFRIQuery() accepts a tampered opening")
+	}
+	// valid openings at a position other than the sampled one
+	if _, err := CheckFRIQuery(l.Roots, l.Challenges, offset, gen, 32, 7, l.Query(5, h), h, m); err != errFRIIndex {
+		t.Errorf("CheckFRIQuery() of the openings at 5 for index 7: error %v, expected errFRIIndex", err)
+	}
+	if _, err := CheckFRIQuery(l.Roots, l.Challenges, big.NewInt(0), gen, 32, 7, l.Query(7, h), h, m); err != errFRIDomain {
+		t.Errorf("CheckFRIQuery() with offset 0: error %v, expected errFRIDomain", err)
+	}
+}
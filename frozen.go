@@ -0,0 +1,76 @@
+package polynomial
+
+import "math/big"
+
+// FrozenPoly is an immutable polynomial that is safe to share between goroutines
+// Poly shares its *big.Int coefficients, and Mul, Div and Gcd reduce their operands in place
+// (see sanitize()), so a Poly read by one goroutine while another one multiplies it is a race
+// A FrozenPoly owns a private deep copy and only ever hands out fresh copies
+type FrozenPoly struct {
+	p Poly
+}
+
+// Freeze() returns an immutable deep copy of P; an empty P freezes to 0
+func (p Poly) Freeze() FrozenPoly {
+	if len(p) == 0 {
+		return FrozenPoly{NewPolyInts(0)}
+	}
+	f := FrozenPoly{p.Clone(0)}
+	f.p.trim()
+	return f
+}
+
+// Poly() returns a mutable deep copy
+func (f FrozenPoly) Poly() Poly {
+	return f.p.Clone(0)
+}
+
+// GetDegree() returns the degree
+func (f FrozenPoly) GetDegree() int {
+	return f.p.GetDegree()
+}
+
+// Coeff() returns a copy of the coefficient of x^i (0 beyond the degree)
+func (f FrozenPoly) Coeff(i int) *big.Int {
+	return f.p.Coeff(i)
+}
+
+func (f FrozenPoly) String() string {
+	return f.p.String()
+}
+
+// Compare() compares like Poly.Compare()
+func (f FrozenPoly) Compare(g FrozenPoly) int {
+	return f.p.Compare(&g.p)
+}
+
+// Add() returns F + Q as a new FrozenPoly
+func (f FrozenPoly) Add(q FrozenPoly, m *big.Int) FrozenPoly {
+	return FrozenPoly{f.p.Add(q.p, m)}
+}
+
+// Sub() returns F - Q as a new FrozenPoly
+func (f FrozenPoly) Sub(q FrozenPoly, m *big.Int) FrozenPoly {
+	return FrozenPoly{f.p.Sub(q.p, m)}
+}
+
+// Mul() returns F * Q as a new FrozenPoly
+func (f FrozenPoly) Mul(q FrozenPoly, m *big.Int) FrozenPoly {
+	return FrozenPoly{f.Poly().Mul(q.Poly(), m)}
+}
+
+// Div() returns (F / Q, F % Q) as new FrozenPolys
+func (f FrozenPoly) Div(q FrozenPoly, m *big.Int) (quo, rem FrozenPoly) {
+	a, b := f.Poly().Div(q.Poly(), m)
+	return FrozenPoly{a}, FrozenPoly{b}
+}
+
+// Gcd() returns the GCD of F and Q as a new FrozenPoly
+func (f FrozenPoly) Gcd(q FrozenPoly, m *big.Int) FrozenPoly {
+	return FrozenPoly{f.Poly().Gcd(q.Poly(), m).Clone(0)}
+}
+
+// Eval() returns F(x)
+func (f FrozenPoly) Eval(x, m *big.Int) *big.Int {
+	return f.p.Eval(x, m)
+}
@@ -0,0 +1,58 @@
+package polynomial
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestFreeze(t *testing.T) {
+	p := NewPolyInts(5, -4, 3, 3)
+	f := p.Freeze()
+	p[0].SetInt64(100)
+	if c := f.Coeff(0); c.Int64() != 5 {
+		t.Errorf("changing the original polynomial changes the frozen one (constant: %v)", c)
+	}
+	c := f.Coeff(1)
+	c.SetInt64(7)
+	if f.Coeff(1).Int64() != -4 {
+		t.Errorf("Coeff() should return a copy")
+	}
+	q := f.Poly()
+	q[2].SetInt64(0)
+	if f.Coeff(2).Int64() != 3 {
+		t.Errorf("Poly() should return a deep copy")
+	}
+	if f.Coeff(10).Sign() != 0 || f.Coeff(-1).Sign() != 0 {
+		t.Errorf("coefficients beyond the degree should be zero")
+	}
+	if z := Poly(nil).Freeze(); z.GetDegree() != 0 || z.Coeff(0).Sign() != 0 {
+		t.Errorf("Poly(nil).Freeze() = %v, want 0", z.Poly())
+	}
+}
+
+// Run with -race: Mul reduces a plain Poly in place, but not a frozen one
+func TestFrozenConcurrentUse(t *testing.T) {
+	m := big.NewInt(11)
+	f := NewPolyInts(-4, 0, 0, 3, 0, -1).Freeze()
+	g := NewPolyInts(0, 0, 0, 4, 0, 0, 6).Freeze()
+	ans := NewPolyInts(-4, 0, 0, 3, 0, -1).Mul(NewPolyInts(0, 0, 0, 4, 0, 0, 6), m)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				r := f.Mul(g, m).Poly()
+				if r.Compare(&ans) != 0 {
+					t.Errorf("%v * %v != %v (your answer was %v)", f, g, ans, r)
+				}
+				f.Eval(big.NewInt(int64(j)), m)
+			}
+		}()
+	}
+	wg.Wait()
+	if f.Coeff(0).Int64() != -4 {
+		t.Errorf("Mul() reduced a frozen polynomial in place (constant: %v)", f.Coeff(0))
+	}
+}
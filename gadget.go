@@ -0,0 +1,65 @@
+package polynomial
+
+import "math/big"
+
+// GadgetDecompose() splits P into levels digit polynomials in base B = 2^logBase with P = sum_i B^i D_i, as used
+// by key switching and external products
+// Unsigned digits lie in [0, B), and a negative coefficient gets the negated digits of its absolute value; signed
+// digits lie in [-B/2, B/2), which halves their norm. The decomposition is exact when every coefficient is below
+// B^levels in absolute value (or, for signed digits, in the balanced range of levels digits); higher digits are
+// dropped otherwise
+// P must pass Validate() (or be empty), and it panics if logBase is 0 or levels is negative
+func GadgetDecompose(p Poly, logBase uint, levels int, signed bool) []Poly {
+	if logBase < 1 || levels < 0 {
+		panic("polynomial: the gadget base must be at least 2 and the number of levels non-negative")
+	}
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), logBase), big.NewInt(1))
+	half := new(big.Int).Lsh(big.NewInt(1), logBase-1)
+	ds := make([]Poly, levels)
+	for i := range ds {
+		ds[i] = make(Poly, len(p))
+	}
+	for j, c := range p {
+		x := new(big.Int).Abs(c)
+		neg := c.Sign() < 0 && !signed
+		if signed {
+			x.Set(c)
+		}
+		for i := range ds {
+			// And() works on two's complement, so it is the residue in [0, B) for negative x too
+			d := new(big.Int).And(x, mask)
+			if signed && d.Cmp(half) >= 0 {
+				d.Sub(d, mask).Sub(d, big.NewInt(1))
+			}
+			x.Sub(x, d).Rsh(x, logBase)
+			if neg {
+				d.Neg(d)
+			}
+			ds[i][j] = d
+		}
+	}
+	for i := range ds {
+		if len(p) == 0 {
+			ds[i] = NewPolyInts(0)
+		}
+		ds[i].trim()
+	}
+	return ds
+}
+
+// GadgetRecompose() returns sum_i B^i D_i for B = 2^logBase, the inverse of GadgetDecompose()
+// modulo m can be nil
+func GadgetRecompose(ds []Poly, logBase uint, m *big.Int) Poly {
+	r := NewPolyInts(0)
+	for i := len(ds) - 1; i >= 0; i-- {
+		for j, c := range r {
+			r[j] = c.Lsh(c, logBase)
+		}
+		r = r.Add(ds[i], m)
+	}
+	if m != nil {
+		r.sanitize(m)
+		r.trim()
+	}
+	return r
+}
@@ -0,0 +1,57 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGadgetDecompose(t *testing.T) {
+	cases := []struct {
+		p       Poly
+		logBase uint
+		levels  int
+		signed  bool
+		want    []Poly
+	}{
+		// 0x1d3 = 3 + 13 * 16 + 1 * 256 in base 16
+		{NewPolyInts(0x1d3, 5), 4, 3, false, []Poly{NewPolyInts(3, 5), NewPolyInts(13), NewPolyInts(1)}},
+		// balanced: 13 = -3 + 16, so 0x1d3 = 3 - 3 * 16 + 2 * 256
+		{NewPolyInts(0x1d3, 5), 4, 3, true, []Poly{NewPolyInts(3, 5), NewPolyInts(-3), NewPolyInts(2)}},
+		{NewPolyInts(-6), 1, 3, false, []Poly{NewPolyInts(0), NewPolyInts(-1), NewPolyInts(-1)}},
+		// -6 = 2 - 2 * 4 in balanced base 4
+		{NewPolyInts(-6), 2, 2, true, []Poly{NewPolyInts(-2), NewPolyInts(-1)}},
+		{NewPolyInts(0), 8, 2, true, []Poly{NewPolyInts(0), NewPolyInts(0)}},
+	}
+	for _, c := range cases {
+		ds := GadgetDecompose(c.p, c.logBase, c.levels, c.signed)
+		if len(ds) != len(c.want) {
+			t.Fatalf("GadgetDecompose(%v, %d) returned %d digits", c.p, c.logBase, len(ds))
+		}
+		for i := range ds {
+			if !ds[i].Equal(c.want[i]) {
+				t.Errorf("GadgetDecompose(%v, %d, signed %v)[%d] = %v, expected %v", c.p, c.logBase, c.signed, i, ds[i], c.want[i])
+			}
+		}
+		if got := GadgetRecompose(ds, c.logBase, nil); !got.Equal(c.p) {
+			t.Errorf("GadgetRecompose() = %v, expected %v", got, c.p)
+		}
+	}
+}
+
+func TestGadgetRoundTrip(t *testing.T) {
+	q := big.NewInt(1 << 40)
+	p := RandomPoly(31, 40)
+	for _, signed := range []bool{false, true} {
+		ds := GadgetDecompose(p, 5, 8, signed)
+		for _, d := range ds {
+			for _, c := range d {
+				if signed && (c.Int64() < -16 || c.Int64() >= 16) || !signed && (c.Sign() < 0 || c.Int64() >= 32) {
+					t.Errorf("digit %v out of range (signed %v)", c, signed)
+				}
+			}
+		}
+		if got := GadgetRecompose(ds, 5, q); !got.Equal(p) {
+			t.Errorf("GadgetRecompose() mod 2^40 = %v, expected %v", got, p)
+		}
+	}
+}
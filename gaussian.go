@@ -0,0 +1,44 @@
+package polynomial
+
+import (
+	"crypto/rand"
+	"math"
+	"math/big"
+)
+
+// gaussianTail is the number of standard deviations beyond which SampleGaussian() never draws; the mass it cuts
+// off is below 2^-100
+const gaussianTail = 12
+
+// randomFloat() returns a uniform float64 in [0, 1) from 53 bits of crypto/rand
+func randomFloat() float64 {
+	r, _ := rand.Int(rand.Reader, big.NewInt(1<<53))
+	return float64(r.Int64()) / (1 << 53)
+}
+
+// SampleGaussian() returns a polynomial of n coefficients drawn independently from the discrete Gaussian of
+// parameter sigma centered at 0, with Pr[x] proportional to exp(-x^2 / (2 sigma^2))
+// Every coefficient is drawn uniformly from [-12 sigma, 12 sigma] and kept with that probability (rejection
+// sampling on crypto/rand); it is an example-quality sampler and does not run in constant time
+// Panics if sigma is not positive
+func SampleGaussian(n int, sigma float64) Poly {
+	if !(sigma > 0) {
+		panic("polynomial: the Gaussian parameter must be positive")
+	}
+	bound := int64(math.Ceil(gaussianTail * sigma))
+	width := big.NewInt(2*bound + 1)
+	p := make(Poly, n+1)
+	p[n] = big.NewInt(0)
+	for i := 0; i < n; i++ {
+		for {
+			r, _ := rand.Int(rand.Reader, width)
+			x := float64(r.Int64() - bound)
+			if randomFloat() < math.Exp(-x*x/(2*sigma*sigma)) {
+				p[i] = big.NewInt(int64(x))
+				break
+			}
+		}
+	}
+	p.trim()
+	return p
+}
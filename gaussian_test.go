@@ -0,0 +1,30 @@
+package polynomial
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSampleGaussian(t *testing.T) {
+	const n, sigma = 4096, 3.2
+	p := SampleGaussian(n, sigma)
+	if len(p) > n {
+		t.Fatalf("SampleGaussian(%d) has %d coefficients", n, len(p))
+	}
+	var sum, sq float64
+	for _, c := range p {
+		x := float64(c.Int64())
+		if math.Abs(x) > gaussianTail*sigma+1 {
+			t.Errorf("coefficient %v is beyond the tail cut", c)
+		}
+		sum += x
+		sq += x * x
+	}
+	// mean and variance within a handful of standard errors
+	if mean := sum / n; math.Abs(mean) > 0.3 {
+		t.Errorf("mean = %v, expected about 0", mean)
+	}
+	if v := sq / n; math.Abs(v-sigma*sigma) > 1.5 {
+		t.Errorf("variance = %v, expected about %v", v, sigma*sigma)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/jongukim/polynomial
+
+go 1.24
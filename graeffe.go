@@ -0,0 +1,22 @@
+package polynomial
+
+import "math/big"
+
+// Graeffe() returns the polynomial whose roots are the 2^k-th powers of the roots of P
+// One step with P(x) = E(x^2) + x O(x^2) is Q(y) = (-1)^n (E(y)^2 - y O(y)^2), so Q(x^2) = (-1)^n P(x) P(-x)
+// The leading coefficient becomes a_n^(2^k), so the result stays monic for monic P
+// modulo m can be nil
+func (p Poly) Graeffe(k int, m *big.Int) Poly {
+	q := p.Clone(0)
+	q.sanitize(m)
+	q.trim()
+	for ; k > 0; k-- {
+		e, o := q.EvenOdd()
+		q = e.Clone(0).Mul(e, m).Sub(o.Clone(0).Mul(o, m).MulXn(1), m)
+		if q.GetDegree()%2 == 1 {
+			q = q.Neg()
+			q.sanitize(m)
+		}
+	}
+	return q
+}
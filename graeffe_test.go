@@ -0,0 +1,31 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGraeffe(t *testing.T) {
+	cases := []struct {
+		p    Poly
+		k    int
+		m    *big.Int
+		want Poly
+	}{
+		// roots 1, 2, 3 become 1, 4, 9 and then 1, 16, 81
+		{Wilkinson(3), 1, nil, RootPoly(bigs(1, 4, 9), nil)},
+		{Wilkinson(3), 2, nil, RootPoly(bigs(1, 16, 81), nil)},
+		// roots +-i become -1, -1
+		{NewPolyInts(1, 0, 1), 1, nil, NewPolyInts(1, 2, 1)},
+		{NewPolyInts(-3, 2), 3, nil, NewPolyInts(-6561, 256)},
+		{Wilkinson(4), 0, nil, Wilkinson(4)},
+		{Wilkinson(3), 1, big.NewInt(7), RootPoly(bigs(1, 4, 9), nil)},
+	}
+	for _, c := range cases {
+		want := c.want.Clone(0)
+		want.sanitize(c.m)
+		if got := c.p.Graeffe(c.k, c.m); got.Compare(&want) != 0 {
+			t.Errorf("Graeffe(%v, %d) mod %v = %v, want %v", c.p, c.k, c.m, got, want)
+		}
+	}
+}
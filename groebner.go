@@ -0,0 +1,292 @@
+package polynomial
+
+import (
+	"math/big"
+	"sort"
+)
+
+// MonomialOrder compares exponent vectors and returns -1, 0, or 1; it must be a well-ordering compatible with
+// multiplication
+type MonomialOrder func(a, b []int) int
+
+// LexOrder compares exponents lexicographically with x1 > x2 > ... (the order MultiPoly keeps its terms in)
+func LexOrder(a, b []int) int {
+	return lexCmp(a, b)
+}
+
+func totalDegree(e []int) int {
+	s := 0
+	for _, d := range e {
+		s += d
+	}
+	return s
+}
+
+// GrLexOrder compares the total degree first and breaks ties lexicographically
+func GrLexOrder(a, b []int) int {
+	if da, db := totalDegree(a), totalDegree(b); da != db {
+		if da > db {
+			return 1
+		}
+		return -1
+	}
+	return lexCmp(a, b)
+}
+
+// GrevLexOrder compares the total degree first; on a tie the monomial with the smaller exponent in the last
+// variable where they differ is larger
+func GrevLexOrder(a, b []int) int {
+	if da, db := totalDegree(a), totalDegree(b); da != db {
+		if da > db {
+			return 1
+		}
+		return -1
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		switch {
+		case a[i] < b[i]:
+			return 1
+		case a[i] > b[i]:
+			return -1
+		}
+	}
+	return 0
+}
+
+// LeadingTerm() returns the largest term of a non-zero P under ord
+func (p MultiPoly) LeadingTerm(ord MonomialOrder) MonoTerm {
+	lt := p.Terms[0]
+	for _, t := range p.Terms[1:] {
+		if ord(t.Exp, lt.Exp) > 0 {
+			lt = t
+		}
+	}
+	return lt
+}
+
+// expDivides() checks that x^a divides x^b
+func expDivides(a, b []int) bool {
+	for i := range a {
+		if a[i] > b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func expSub(a, b []int) []int {
+	r := make([]int, len(a))
+	for i := range a {
+		r[i] = a[i] - b[i]
+	}
+	return r
+}
+
+func expLcm(a, b []int) []int {
+	r := make([]int, len(a))
+	for i := range a {
+		r[i] = a[i]
+		if b[i] > r[i] {
+			r[i] = b[i]
+		}
+	}
+	return r
+}
+
+// mulTerm() returns c x^e P
+func (p MultiPoly) mulTerm(e []int, c, m *big.Int) MultiPoly {
+	return p.Mul(NewMultiPoly(p.NVars, MonoTerm{e, c}), m)
+}
+
+// scale() returns c P
+func (p MultiPoly) scale(c, m *big.Int) MultiPoly {
+	return p.mulTerm(make([]int, p.NVars), c, m)
+}
+
+// normalize() makes P monic modulo m, or primitive with a positive leading coefficient over Z
+func (p MultiPoly) normalize(ord MonomialOrder, m *big.Int) MultiPoly {
+	if p.IsZero() {
+		return p
+	}
+	lc := p.LeadingTerm(ord).Coeff
+	if m != nil {
+		return p.scale(new(big.Int).ModInverse(lc, m), m)
+	}
+	g := new(big.Int)
+	for _, t := range p.Terms {
+		g.GCD(nil, nil, g, new(big.Int).Abs(t.Coeff))
+	}
+	if lc.Sign() < 0 {
+		g.Neg(g)
+	}
+	q := MultiPoly{NVars: p.NVars, Terms: make([]MonoTerm, len(p.Terms))}
+	for i, t := range p.Terms {
+		q.Terms[i] = MonoTerm{append([]int(nil), t.Exp...), new(big.Int).Quo(t.Coeff, g)}
+	}
+	return q
+}
+
+// DivRem() divides P by gs under ord and returns quotients qs, a remainder r none of whose terms is divisible by
+// a leading term of gs, and a scale with scale * P = sum qs[i] gs[i] + r
+// Modulo a prime m the scale is 1; over Z every reduction step multiplies by the cofactor of a leading coefficient
+// instead of dividing, so all coefficients stay integral (and the remainder agrees with the one over Q up to scale)
+func (p MultiPoly) DivRem(gs []MultiPoly, ord MonomialOrder, m *big.Int) (qs []MultiPoly, r MultiPoly, scale *big.Int) {
+	nv := p.NVars
+	qs = make([]MultiPoly, len(gs))
+	for i := range qs {
+		qs[i] = MultiPoly{NVars: nv}
+	}
+	r = MultiPoly{NVars: nv}
+	scale = big.NewInt(1)
+	lts := make([]MonoTerm, len(gs))
+	for i, g := range gs {
+		if !g.IsZero() {
+			lts[i] = g.LeadingTerm(ord)
+		}
+	}
+	f := p.Add(MultiPoly{NVars: nv}, m)
+	for !f.IsZero() {
+		lt := f.LeadingTerm(ord)
+		i := 0
+		for ; i < len(gs); i++ {
+			if !gs[i].IsZero() && expDivides(lts[i].Exp, lt.Exp) {
+				break
+			}
+		}
+		if i == len(gs) {
+			r = r.Add(NewMultiPoly(nv, lt), m)
+			f = f.Sub(NewMultiPoly(nv, lt), m)
+			continue
+		}
+		e := expSub(lt.Exp, lts[i].Exp)
+		var c *big.Int
+		if m != nil {
+			c = new(big.Int).ModInverse(lts[i].Coeff, m)
+			c.Mul(c, lt.Coeff).Mod(c, m)
+		} else {
+			// a f - b x^e g cancels the leading term with a = lc(g) / d, b = lt(f) / d
+			d := new(big.Int).GCD(nil, nil, new(big.Int).Abs(lt.Coeff), new(big.Int).Abs(lts[i].Coeff))
+			a := new(big.Int).Quo(lts[i].Coeff, d)
+			c = new(big.Int).Quo(lt.Coeff, d)
+			if a.Cmp(big.NewInt(1)) != 0 {
+				f = f.scale(a, nil)
+				r = r.scale(a, nil)
+				for j := range qs {
+					qs[j] = qs[j].scale(a, nil)
+				}
+				scale.Mul(scale, a)
+			}
+		}
+		qs[i] = qs[i].Add(NewMultiPoly(nv, MonoTerm{e, c}), m)
+		f = f.Sub(gs[i].mulTerm(e, c, m), m)
+	}
+	return qs, r, scale
+}
+
+// sPoly() returns the S-polynomial of F and G, the combination of lcm / lt(F) F and lcm / lt(G) G that cancels
+// their leading terms (scaled to integers over Z)
+func sPoly(f, g MultiPoly, ord MonomialOrder, m *big.Int) MultiPoly {
+	lf, lg := f.LeadingTerm(ord), g.LeadingTerm(ord)
+	l := expLcm(lf.Exp, lg.Exp)
+	cf, cg := new(big.Int).Set(lg.Coeff), new(big.Int).Set(lf.Coeff)
+	if m != nil {
+		cf.ModInverse(lf.Coeff, m)
+		cg.ModInverse(lg.Coeff, m)
+	} else {
+		d := new(big.Int).GCD(nil, nil, new(big.Int).Abs(lf.Coeff), new(big.Int).Abs(lg.Coeff))
+		cf.Quo(cf, d)
+		cg.Quo(cg, d)
+	}
+	return f.mulTerm(expSub(l, lf.Exp), cf, m).Sub(g.mulTerm(expSub(l, lg.Exp), cg, m), m)
+}
+
+// GroebnerBasis() returns the reduced Gröbner basis of the ideal generated by gens under ord, with coefficients
+// modulo a prime m (monic elements) or over Q (primitive integer elements with positive leading coefficients)
+// Buchberger's algorithm skips pairs with coprime leading monomials and pairs (i, j) for which some k has
+// lt(g_k) | lcm(lt(g_i), lt(g_j)) and both (i, k) and (j, k) were already treated; the basis is sorted by
+// decreasing leading monomial
+func GroebnerBasis(gens []MultiPoly, ord MonomialOrder, m *big.Int) []MultiPoly {
+	var g []MultiPoly
+	for _, f := range gens {
+		if f = f.Add(MultiPoly{NVars: f.NVars}, m); !f.IsZero() {
+			g = append(g, f.normalize(ord, m))
+		}
+	}
+	type pair struct{ i, j int }
+	var pairs []pair
+	done := map[pair]bool{}
+	for j := range g {
+		for i := 0; i < j; i++ {
+			pairs = append(pairs, pair{i, j})
+		}
+	}
+	treated := func(i, j int) bool {
+		if i > j {
+			i, j = j, i
+		}
+		return done[pair{i, j}]
+	}
+	for len(pairs) > 0 {
+		pr := pairs[0]
+		pairs = pairs[1:]
+		done[pr] = true
+		li, lj := g[pr.i].LeadingTerm(ord).Exp, g[pr.j].LeadingTerm(ord).Exp
+		l := expLcm(li, lj)
+		if totalDegree(l) == totalDegree(li)+totalDegree(lj) {
+			// coprime leading monomials: the S-polynomial reduces to zero
+			continue
+		}
+		chain := false
+		for k := range g {
+			if k != pr.i && k != pr.j && expDivides(g[k].LeadingTerm(ord).Exp, l) && treated(pr.i, k) && treated(pr.j, k) {
+				chain = true
+				break
+			}
+		}
+		if chain {
+			continue
+		}
+		_, r, _ := sPoly(g[pr.i], g[pr.j], ord, m).DivRem(g, ord, m)
+		if r.IsZero() {
+			continue
+		}
+		g = append(g, r.normalize(ord, m))
+		for i := 0; i < len(g)-1; i++ {
+			pairs = append(pairs, pair{i, len(g) - 1})
+		}
+	}
+	return reduceBasis(g, ord, m)
+}
+
+// reduceBasis() drops the elements whose leading monomial is divisible by another one and reduces every
+// remaining element by the others
+func reduceBasis(g []MultiPoly, ord MonomialOrder, m *big.Int) []MultiPoly {
+	var minimal []MultiPoly
+	for i, f := range g {
+		lf := f.LeadingTerm(ord).Exp
+		redundant := false
+		for j, h := range g {
+			lh := h.LeadingTerm(ord).Exp
+			// among equal leading monomials keep the first one
+			if j != i && expDivides(lh, lf) && (ord(lh, lf) != 0 || j < i) {
+				redundant = true
+				break
+			}
+		}
+		if !redundant {
+			minimal = append(minimal, f)
+		}
+	}
+	red := make([]MultiPoly, len(minimal))
+	for i, f := range minimal {
+		others := append(append([]MultiPoly(nil), minimal[:i]...), minimal[i+1:]...)
+		lt := f.LeadingTerm(ord)
+		tail := f.Sub(NewMultiPoly(f.NVars, lt), m)
+		_, r, s := tail.DivRem(others, ord, m)
+		red[i] = NewMultiPoly(f.NVars, MonoTerm{lt.Exp, new(big.Int).Mul(lt.Coeff, s)}).Add(r, m).normalize(ord, m)
+	}
+	sort.Slice(red, func(i, j int) bool {
+		return ord(red[i].LeadingTerm(ord).Exp, red[j].LeadingTerm(ord).Exp) > 0
+	})
+	return red
+}
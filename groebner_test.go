@@ -0,0 +1,135 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMonomialOrders(t *testing.T) {
+	cases := []struct {
+		a, b               []int
+		lex, grlex, grevlx int
+	}{
+		{[]int{1, 0, 0}, []int{0, 2, 0}, 1, -1, -1},
+		// x1 x2 x3^2 and x1 x2^2 x3 have the same degree
+		{[]int{1, 1, 2}, []int{1, 2, 1}, -1, -1, -1},
+		{[]int{1, 1, 2}, []int{1, 2, 0}, -1, 1, 1},
+		{[]int{1, 2, 1}, []int{1, 1, 2}, 1, 1, 1},
+		{[]int{2, 0, 2}, []int{1, 2, 1}, 1, 1, -1},
+		{[]int{0, 1, 0}, []int{0, 1, 0}, 0, 0, 0},
+	}
+	for _, c := range cases {
+		if got := LexOrder(c.a, c.b); got != c.lex {
+			t.Errorf("LexOrder(%v, %v) = %d, expected %d", c.a, c.b, got, c.lex)
+		}
+		if got := GrLexOrder(c.a, c.b); got != c.grlex {
+			t.Errorf("GrLexOrder(%v, %v) = %d, expected %d", c.a, c.b, got, c.grlex)
+		}
+		if got := GrevLexOrder(c.a, c.b); got != c.grevlx {
+			t.Errorf("GrevLexOrder(%v, %v) = %d, expected %d", c.a, c.b, got, c.grevlx)
+		}
+	}
+}
+
+func TestDivRem(t *testing.T) {
+	// x1^2 x2 + x1 x2^2 + x2^2 by x1 x2 - 1 and x2^2 - 1 (lex): quotients x1 + x2 and 1, remainder x1 + x2 + 1
+	f := NewMultiPoly(2, mono(1, 2, 1), mono(1, 1, 2), mono(1, 0, 2))
+	gs := []MultiPoly{NewMultiPoly(2, mono(1, 1, 1), mono(-1, 0, 0)), NewMultiPoly(2, mono(1, 0, 2), mono(-1, 0, 0))}
+	qs, r, s := f.DivRem(gs, LexOrder, nil)
+	wantR := NewMultiPoly(2, mono(1, 1, 0), mono(1, 0, 1), mono(1, 0, 0))
+	if !r.Equal(wantR) || s.Int64() != 1 {
+		t.Errorf("DivRem() remainder %v scale %v, expected %v", r, s, wantR)
+	}
+	sum := r
+	for i := range gs {
+		sum = sum.Add(qs[i].Mul(gs[i], nil), nil)
+	}
+	if !sum.Equal(f) {
+		t.Errorf("sum q_i g_i + r = %v, expected %v", sum, f)
+	}
+
+	// over Z the leading coefficient 2 scales the dividend: 4 x1^2 = (2x1 - 1)(2x1 + 1) + 1
+	f = NewMultiPoly(1, mono(1, 2))
+	g := NewMultiPoly(1, mono(2, 1), mono(1, 0))
+	qs, r, s = f.DivRem([]MultiPoly{g}, LexOrder, nil)
+	if s.Int64() != 4 || !r.Equal(NewMultiPoly(1, mono(1, 0))) || !qs[0].Equal(NewMultiPoly(1, mono(2, 1), mono(-1, 0))) {
+		t.Errorf("DivRem(x1^2, 2x1 + 1) = %v, %v, %v", qs, r, s)
+	}
+	// modulo 7 no scaling is needed: x1^2 = (4x1 + 5)(2x1 + 1) + 2
+	qs, r, s = f.DivRem([]MultiPoly{g}, LexOrder, big.NewInt(7))
+	if s.Int64() != 1 || !r.Equal(NewMultiPoly(1, mono(2, 0))) || !qs[0].Equal(NewMultiPoly(1, mono(4, 1), mono(5, 0))) {
+		t.Errorf("DivRem(x1^2, 2x1 + 1) mod 7 = %v, %v, %v", qs, r, s)
+	}
+}
+
+func TestGroebnerBasis(t *testing.T) {
+	circle := NewMultiPoly(2, mono(1, 2, 0), mono(1, 0, 2), mono(-1, 0, 0))
+	line := NewMultiPoly(2, mono(1, 1, 0), mono(-1, 0, 1))
+	// Cox, Little and O'Shea: x^3 - 2xy and x^2 y - 2y^2 + x under grlex
+	f1 := NewMultiPoly(2, mono(1, 3, 0), mono(-2, 1, 1))
+	f2 := NewMultiPoly(2, mono(1, 2, 1), mono(-2, 0, 2), mono(1, 1, 0))
+	cases := []struct {
+		gens []MultiPoly
+		ord  MonomialOrder
+		m    *big.Int
+		want []MultiPoly
+	}{
+		{[]MultiPoly{circle, line}, LexOrder, nil, []MultiPoly{line, NewMultiPoly(2, mono(2, 0, 2), mono(-1, 0, 0))}},
+		// 1/2 = 4 mod 7
+		{[]MultiPoly{circle, line}, LexOrder, big.NewInt(7), []MultiPoly{
+			NewMultiPoly(2, mono(1, 1, 0), mono(6, 0, 1)), NewMultiPoly(2, mono(1, 0, 2), mono(3, 0, 0)),
+		}},
+		{[]MultiPoly{f1, f2}, GrLexOrder, nil, []MultiPoly{
+			NewMultiPoly(2, mono(1, 2, 0)), NewMultiPoly(2, mono(1, 1, 1)), NewMultiPoly(2, mono(2, 0, 2), mono(-1, 1, 0)),
+		}},
+		// x1 and x1 + 1 generate the unit ideal
+		{[]MultiPoly{NewMultiPoly(1, mono(1, 1)), NewMultiPoly(1, mono(1, 1), mono(1, 0))}, GrevLexOrder, nil, []MultiPoly{
+			NewMultiPoly(1, mono(1, 0)),
+		}},
+		{[]MultiPoly{{NVars: 2}}, LexOrder, nil, nil},
+	}
+	for _, c := range cases {
+		got := GroebnerBasis(c.gens, c.ord, c.m)
+		if len(got) != len(c.want) {
+			t.Errorf("GroebnerBasis(%v) = %v, expected %v", c.gens, got, c.want)
+			continue
+		}
+		for i := range got {
+			if !got[i].Equal(c.want[i]) {
+				t.Errorf("GroebnerBasis(%v) = %v, expected %v", c.gens, got, c.want)
+				break
+			}
+		}
+		// every generator reduces to zero
+		for _, f := range c.gens {
+			if _, r, _ := f.DivRem(got, c.ord, c.m); !r.IsZero() {
+				t.Errorf("%v leaves the remainder %v modulo its Gröbner basis", f, r)
+			}
+		}
+	}
+}
+
+func TestGroebnerBasisCriterion(t *testing.T) {
+	// Buchberger's criterion: every S-polynomial of the result reduces to zero
+	m := big.NewInt(101)
+	gens := []MultiPoly{
+		NewMultiPoly(3, mono(1, 2, 0, 0), mono(3, 0, 1, 1), mono(-1, 0, 0, 0)),
+		NewMultiPoly(3, mono(1, 1, 1, 0), mono(-2, 0, 0, 2), mono(5, 1, 0, 0)),
+		NewMultiPoly(3, mono(1, 0, 2, 0), mono(1, 1, 0, 1), mono(7, 0, 0, 0)),
+	}
+	for _, ord := range []MonomialOrder{GrevLexOrder, LexOrder} {
+		g := GroebnerBasis(gens, ord, m)
+		for i := range g {
+			for j := 0; j < i; j++ {
+				if _, r, _ := sPoly(g[i], g[j], ord, m).DivRem(g, ord, m); !r.IsZero() {
+					t.Errorf("S(g_%d, g_%d) leaves %v", i, j, r)
+				}
+			}
+		}
+		for _, f := range gens {
+			if _, r, _ := f.DivRem(g, ord, m); !r.IsZero() {
+				t.Errorf("%v leaves the remainder %v", f, r)
+			}
+		}
+	}
+}
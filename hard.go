@@ -0,0 +1,53 @@
+package polynomial
+
+import "math/big"
+
+// SwinnertonDyer() returns the Swinnerton-Dyer polynomial of the first n primes,
+// the product of (x - (+-sqrt 2 +- sqrt 3 +- ... +- sqrt p_n)) over all sign choices
+// It is irreducible over Z but splits into factors of degree at most 2 modulo every prime,
+// which is the worst case for factoring by recombination
+func SwinnertonDyer(n int) Poly {
+	if n < 0 {
+		panic("polynomial: the number of primes must be non-negative")
+	}
+	s := NewPolyInts(0, 1)
+	for q := int64(2); n > 0; q++ {
+		if !big.NewInt(q).ProbablyPrime(0) {
+			continue
+		}
+		s = conjugateProduct(s, big.NewInt(q))
+		n--
+	}
+	return s
+}
+
+// conjugateProduct() returns f(x + sqrt q) f(x - sqrt q) = A^2 - q B^2, where f(x + y) = A + yB modulo y^2 = q
+func conjugateProduct(f Poly, q *big.Int) Poly {
+	a, b := NewPolyInts(0), NewPolyInts(0)
+	for i := f.GetDegree(); i >= 0; i-- {
+		// (A + yB)(x + y) + c = (xA + qB + c) + y(xB + A)
+		a, b = a.Clone(1).Add(b.Clone(0).Mul(Poly{q}, nil), nil).Add(Poly{f[i]}, nil), b.Clone(1).Add(a, nil)
+	}
+	return a.Clone(0).Mul(a, nil).Sub(b.Clone(0).Mul(b, nil).Mul(Poly{q}, nil), nil)
+}
+
+// Mignotte() returns x^n - 2(ax - 1)^2, whose two real roots near 1/a are closer than 2a^(-(n+2)/2)
+// It stresses root separation, root isolation and GCD-based multiplicity checks
+// n must be at least 3
+func Mignotte(n int, a *big.Int) Poly {
+	if n < 3 {
+		panic("polynomial: the degree of a Mignotte polynomial must be at least 3")
+	}
+	sq := Poly{big.NewInt(-1), new(big.Int).Set(a)}
+	sq = sq.Mul(sq.Clone(0), nil).Mul(NewPolyInts(2), nil)
+	return NewPolyInts(1).Clone(n).Sub(sq, nil)
+}
+
+// Wilkinson() returns (x - 1)(x - 2)...(x - n), whose roots are notoriously ill-conditioned
+func Wilkinson(n int) Poly {
+	roots := make([]*big.Int, n)
+	for i := range roots {
+		roots[i] = big.NewInt(int64(i + 1))
+	}
+	return RootPoly(roots, nil)
+}
@@ -0,0 +1,54 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSwinnertonDyer(t *testing.T) {
+	cases := []struct {
+		n    int
+		want Poly
+	}{
+		{0, NewPolyInts(0, 1)},
+		{1, NewPolyInts(-2, 0, 1)},
+		{2, NewPolyInts(1, 0, -10, 0, 1)},
+		{3, NewPolyInts(576, 0, -960, 0, 352, 0, -40, 0, 1)},
+	}
+	for _, c := range cases {
+		if got := SwinnertonDyer(c.n); got.Compare(&c.want) != 0 {
+			t.Errorf("SwinnertonDyer(%d) = %v, want %v", c.n, got, c.want)
+		}
+	}
+	if d := SwinnertonDyer(5).GetDegree(); d != 32 {
+		t.Errorf("SwinnertonDyer(5) has degree %d, want 32", d)
+	}
+}
+
+func TestMignotte(t *testing.T) {
+	// x^4 - 2(10x - 1)^2 = x^4 - 200x^2 + 40x - 2
+	got := Mignotte(4, big.NewInt(10))
+	if want := NewPolyInts(-2, 40, -200, 0, 1); got.Compare(&want) != 0 {
+		t.Errorf("Mignotte(4, 10) = %v, want %v", got, want)
+	}
+	// the sign changes between 1/a - 1/a^2 and 1/a + 1/a^2 reveal the two close roots
+	p := Mignotte(5, big.NewInt(10))
+	at := func(num int64) int {
+		return NewRatPoly(p).Eval(big.NewRat(num, 1000)).Sign()
+	}
+	if at(90) >= 0 || at(100) <= 0 || at(110) >= 0 {
+		t.Errorf("Mignotte(5, 10) should have two roots around 1/10")
+	}
+}
+
+func TestWilkinson(t *testing.T) {
+	w := Wilkinson(20)
+	if w.GetDegree() != 20 || w[0].String() != "2432902008176640000" {
+		t.Errorf("Wilkinson(20) = %v", w)
+	}
+	for i := int64(1); i <= 20; i++ {
+		if y := w.Eval(big.NewInt(i), nil); y.Sign() != 0 {
+			t.Errorf("Wilkinson(20)(%d) = %v, want 0", i, y)
+		}
+	}
+}
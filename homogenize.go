@@ -0,0 +1,42 @@
+package polynomial
+
+import "math/big"
+
+// Homogenize() inserts a new variable at index v (0 <= v <= NVars) and multiplies every term by the power of it
+// that brings the term to the total degree of P, so that Dehomogenize(v) gives P back
+// Panics if v is out of range
+func (p MultiPoly) Homogenize(v int) MultiPoly {
+	if v < 0 || v > p.NVars {
+		panic("polynomial: Homogenize() variable out of range")
+	}
+	d := p.Degree()
+	r := MultiPoly{NVars: p.NVars + 1, Terms: make([]MonoTerm, len(p.Terms))}
+	for i, t := range p.Terms {
+		e := make([]int, 0, p.NVars+1)
+		e = append(e, t.Exp[:v]...)
+		e = append(e, d-totalDegree(t.Exp))
+		e = append(e, t.Exp[v:]...)
+		r.Terms[i] = MonoTerm{e, new(big.Int).Set(t.Coeff)}
+	}
+	return NewMultiPoly(r.NVars, r.Terms...)
+}
+
+// IsHomogeneous() checks whether all terms of P have the same total degree
+func (p MultiPoly) IsHomogeneous() bool {
+	for _, t := range p.Terms {
+		if totalDegree(t.Exp) != totalDegree(p.Terms[0].Exp) {
+			return false
+		}
+	}
+	return true
+}
+
+// Dehomogenize() sets the variable v to 1 and removes it
+func (p MultiPoly) Dehomogenize(v int, m *big.Int) MultiPoly {
+	return p.PartialEval(map[int]*big.Int{v: big.NewInt(1)}, m)
+}
+
+// Homogenize() returns the bivariate form sum p_i x1^i x2^(n-i) of P, with n the degree of P
+func (p Poly) Homogenize() MultiPoly {
+	return FromUnivariate(p, 1, 0).Homogenize(1)
+}
@@ -0,0 +1,53 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestHomogenize(t *testing.T) {
+	// x1^2 x2 - 3 x1 + 7
+	p := NewMultiPoly(2, mono(1, 2, 1), mono(-3, 1, 0), mono(7, 0, 0))
+	cases := []struct {
+		v    int
+		want MultiPoly
+	}{
+		{2, NewMultiPoly(3, mono(1, 2, 1, 0), mono(-3, 1, 0, 2), mono(7, 0, 0, 3))},
+		{0, NewMultiPoly(3, mono(1, 0, 2, 1), mono(-3, 2, 1, 0), mono(7, 3, 0, 0))},
+		{1, NewMultiPoly(3, mono(1, 2, 0, 1), mono(-3, 1, 2, 0), mono(7, 0, 3, 0))},
+	}
+	for _, c := range cases {
+		h := p.Homogenize(c.v)
+		if !h.Equal(c.want) {
+			t.Errorf("Homogenize(%d) = %v, expected %v", c.v, h, c.want)
+		}
+		if !h.IsHomogeneous() {
+			t.Errorf("Homogenize(%d) = %v is not homogeneous", c.v, h)
+		}
+		if got := h.Dehomogenize(c.v, nil); !got.Equal(p) {
+			t.Errorf("Dehomogenize(%d) = %v, expected %v", c.v, got, p)
+		}
+	}
+	if p.IsHomogeneous() {
+		t.Errorf("%v is not homogeneous", p)
+	}
+	if got := p.Homogenize(2).Dehomogenize(2, big.NewInt(5)); !got.Equal(NewMultiPoly(2, mono(1, 2, 1), mono(2, 1, 0), mono(2, 0, 0))) {
+		t.Errorf("Dehomogenize() mod 5 = %v", got)
+	}
+}
+
+func TestPolyHomogenize(t *testing.T) {
+	cases := []struct {
+		p    Poly
+		want MultiPoly
+	}{
+		{NewPolyInts(1, 2, 3), NewMultiPoly(2, mono(3, 2, 0), mono(2, 1, 1), mono(1, 0, 2))},
+		{NewPolyInts(0, 0, 1), NewMultiPoly(2, mono(1, 2, 0))},
+		{NewPolyInts(4), NewMultiPoly(2, mono(4, 0, 0))},
+	}
+	for _, c := range cases {
+		if got := c.p.Homogenize(); !got.Equal(c.want) {
+			t.Errorf("%v.Homogenize() = %v, expected %v", c.p, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package polynomial
+
+import "math/big"
+
+// InIdeal() checks whether F lies in the ideal generated by gens, with coefficients modulo a prime m or over Q,
+// and returns the remainder of F by the reduced Gröbner basis (under GrevLexOrder) as a witness: it is zero
+// exactly when F is a member, and otherwise the normal form of F (scaled to integer coefficients over Z)
+func InIdeal(f MultiPoly, gens []MultiPoly, m *big.Int) (bool, MultiPoly) {
+	_, r, _ := f.DivRem(GroebnerBasis(gens, GrevLexOrder, m), GrevLexOrder, m)
+	return r.IsZero(), r
+}
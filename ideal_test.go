@@ -0,0 +1,38 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestInIdeal(t *testing.T) {
+	// x1^2 + x2^2 - 1 and x1 - x2
+	gens := []MultiPoly{
+		NewMultiPoly(2, mono(1, 2, 0), mono(1, 0, 2), mono(-1, 0, 0)),
+		NewMultiPoly(2, mono(1, 1, 0), mono(-1, 0, 1)),
+	}
+	cases := []struct {
+		f    MultiPoly
+		m    *big.Int
+		want bool
+	}{
+		{MultiPoly{NVars: 2}, nil, true},
+		{gens[0], nil, true},
+		// 2 x2^2 - 1 = g0 - (x1 + x2) g1
+		{NewMultiPoly(2, mono(2, 0, 2), mono(-1, 0, 0)), nil, true},
+		{NewMultiPoly(2, mono(2, 0, 2), mono(-1, 0, 0)), big.NewInt(7), true},
+		// x1 x2 - 1/2 is a member over Q: 2 x1 x2 - 1
+		{NewMultiPoly(2, mono(2, 1, 1), mono(-1, 0, 0)), nil, true},
+		{NewMultiPoly(2, mono(1, 1, 1), mono(-1, 0, 0)), nil, false},
+		{NewMultiPoly(2, mono(1, 0, 1)), nil, false},
+		// modulo 2, 2 x2^2 - 1 = 1 and the ideal is the whole ring
+		{constMulti(2, 1), big.NewInt(2), true},
+		{constMulti(2, 1), nil, false},
+	}
+	for _, c := range cases {
+		ok, r := InIdeal(c.f, gens, c.m)
+		if ok != c.want || ok != r.IsZero() {
+			t.Errorf("InIdeal(%v) mod %v = %v with remainder %v, expected %v", c.f, c.m, ok, r, c.want)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package polynomial
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// InexactIntegralError lists the degrees i whose coefficient cannot be divided by i+1
+// (not divisible over Z, or i+1 not invertible modulo m)
+type InexactIntegralError struct {
+	Terms []int
+}
+
+func (e *InexactIntegralError) Error() string {
+	ts := make([]string, len(e.Terms))
+	for i, t := range e.Terms {
+		ts[i] = fmt.Sprintf("x^%d", t)
+	}
+	return "polynomial: the antiderivative is not exact for " + strings.Join(ts, ", ")
+}
+
+// Integral() returns the formal antiderivative with a zero constant term
+// Over Z (m == nil) every coefficient must be divisible by i+1; modulo m, i+1 must be invertible
+// Otherwise an *InexactIntegralError lists the offending terms and no polynomial is returned
+func (p Poly) Integral(m *big.Int) (Poly, error) {
+	q := make(Poly, len(p)+1)
+	q[0] = big.NewInt(0)
+	var bad []int
+	for i, c := range p {
+		d := big.NewInt(int64(i + 1))
+		if m != nil {
+			inv := new(big.Int).ModInverse(d, m)
+			if inv == nil {
+				if new(big.Int).Mod(c, m).Sign() != 0 {
+					bad = append(bad, i)
+				}
+				q[i+1] = big.NewInt(0)
+				continue
+			}
+			q[i+1] = inv.Mul(inv, c)
+			q[i+1].Mod(q[i+1], m)
+			continue
+		}
+		quo, rem := new(big.Int).QuoRem(c, d, new(big.Int))
+		if rem.Sign() != 0 {
+			bad = append(bad, i)
+		}
+		q[i+1] = quo
+	}
+	if bad != nil {
+		return nil, &InexactIntegralError{bad}
+	}
+	q.trim()
+	return q, nil
+}
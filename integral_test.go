@@ -0,0 +1,42 @@
+package polynomial
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestIntegral(t *testing.T) {
+	cases := []struct {
+		p   Poly
+		m   *big.Int
+		ans Poly
+		bad []int
+	}{
+		{NewPolyInts(4, 6, 6), nil, NewPolyInts(0, 4, 3, 2), nil},
+		{NewPolyInts(0), nil, NewPolyInts(0), nil},
+		{NewPolyInts(1, 1, 3), nil, nil, []int{1}},
+		{NewPolyInts(1, 1, 1, 1), nil, nil, []int{1, 2, 3}},
+		{NewPolyInts(1, 1), big.NewInt(7), NewPolyInts(0, 1, 4), nil},
+		// x^6 needs 1/7 modulo 7
+		{NewPolyInts(0, 0, 0, 0, 0, 0, 1), big.NewInt(7), nil, []int{6}},
+		// but 7x^6 integrates to x^7 = 0 modulo 7
+		{NewPolyInts(1, 0, 0, 0, 0, 0, 7), big.NewInt(7), NewPolyInts(0, 1), nil},
+	}
+	for _, c := range cases {
+		res, err := c.p.Integral(c.m)
+		if c.bad != nil {
+			e, ok := err.(*InexactIntegralError)
+			if !ok || !reflect.DeepEqual(e.Terms, c.bad) {
+				t.Errorf("integral of %v should fail on %v (error: %v)", c.p, c.bad, err)
+			}
+			continue
+		}
+		if err != nil || res.Compare(&c.ans) != 0 {
+			t.Errorf("integral of %v != %v (your answer was %v, error: %v)", c.p, c.ans, res, err)
+		}
+		if d := res.Derivative(c.m); c.m == nil && d.Compare(&c.p) != 0 {
+			t.Errorf("(integral of %v)' = %v", c.p, d)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package polynomial
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// CheckInvariants() reports the first broken representation invariant of P: those of Validate() (at least one
+// coefficient, no nil coefficient, trimmed leading term) and, when m is not nil, every coefficient in [0, m)
+func (p Poly) CheckInvariants(m *big.Int) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	if m == nil {
+		return nil
+	}
+	for i, c := range p {
+		if c.Sign() < 0 || c.Cmp(m) >= 0 {
+			return fmt.Errorf("polynomial: coefficient %d = %v is not reduced modulo %v", i, c, m)
+		}
+	}
+	return nil
+}
+
+// CheckArithmetic() runs Add, Sub, Mul, Div and Gcd on copies of P and Q and cross-checks the results, for
+// property tests and fuzzers: every result keeps CheckInvariants(m), (P + Q) - Q = P, P Q = Q P and agrees with
+// P(x) Q(x) at a few points, quo Q + rem = P, and modulo a prime deg rem < deg Q and the gcd divides P and Q
+// P and Q must pass Validate(); m is nil or a prime
+func CheckArithmetic(p, q Poly, m *big.Int) error {
+	for _, x := range []Poly{p, q} {
+		if err := x.Validate(); err != nil {
+			return err
+		}
+	}
+	p, q = p.Clone(0), q.Clone(0)
+	p.Normalize(m)
+	q.Normalize(m)
+	sum := p.Add(q, m)
+	diff := sum.Sub(q, m)
+	pq := p.Clone(0).Mul(q.Clone(0), m)
+	qp := q.Clone(0).Mul(p.Clone(0), m)
+	quo, rem := p.Clone(0).Div(q.Clone(0), m)
+	results := []struct {
+		name string
+		r    Poly
+	}{{"P + Q", sum}, {"P + Q - Q", diff}, {"P Q", pq}, {"quo", quo}, {"rem", rem}}
+	for _, x := range results {
+		if err := x.r.CheckInvariants(m); err != nil {
+			return fmt.Errorf("polynomial: %s: %v", x.name, err)
+		}
+	}
+	if !diff.Equal(p) {
+		return fmt.Errorf("polynomial: (P + Q) - Q = %v, expected %v", diff, p)
+	}
+	if !pq.Equal(qp) {
+		return fmt.Errorf("polynomial: P Q = %v but Q P = %v", pq, qp)
+	}
+	for _, x := range []int64{-1, 2, 3} {
+		bx := big.NewInt(x)
+		want := new(big.Int).Mul(p.Eval(bx, m), q.Eval(bx, m))
+		if m != nil {
+			want.Mod(want, m)
+		}
+		if got := pq.Eval(bx, m); got.Cmp(want) != 0 {
+			return fmt.Errorf("polynomial: (P Q)(%d) = %v, expected P(%d) Q(%d) = %v", x, got, x, x, want)
+		}
+	}
+	if back := quo.Clone(0).Mul(q.Clone(0), m).Add(rem, m); !back.Equal(p) {
+		return fmt.Errorf("polynomial: quo Q + rem = %v, expected %v", back, p)
+	}
+	if m == nil || q.isZero() {
+		return nil
+	}
+	if rem.GetDegree() >= q.GetDegree() && !rem.isZero() {
+		return fmt.Errorf("polynomial: deg rem = %d is not below deg Q = %d", rem.GetDegree(), q.GetDegree())
+	}
+	g := p.Clone(0).Gcd(q.Clone(0), m)
+	for _, x := range []Poly{p, q} {
+		if _, r := x.Clone(0).Div(g.Clone(0), m); !r.isZero() {
+			return fmt.Errorf("polynomial: gcd %v does not divide %v", g, x)
+		}
+	}
+	return nil
+}
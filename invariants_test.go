@@ -0,0 +1,52 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCheckInvariants(t *testing.T) {
+	q := big.NewInt(7)
+	cases := []struct {
+		p  Poly
+		m  *big.Int
+		ok bool
+	}{
+		{NewPolyInts(1, 2, 3), nil, true},
+		{NewPolyInts(1, 2, 3), q, true},
+		{NewPolyInts(-1, 2), nil, true},
+		{NewPolyInts(-1, 2), q, false},
+		{NewPolyInts(1, 7), q, false},
+		{Poly{big.NewInt(1), big.NewInt(0)}, nil, false},
+		{Poly{nil}, nil, false},
+		{Poly{}, q, false},
+	}
+	for _, c := range cases {
+		if err := c.p.CheckInvariants(c.m); (err == nil) != c.ok {
+			t.Errorf("%v.CheckInvariants(%v) = %v", []*big.Int(c.p), c.m, err)
+		}
+	}
+}
+
+func TestCheckArithmetic(t *testing.T) {
+	q := big.NewInt(65537)
+	cases := []struct {
+		p, q Poly
+		m    *big.Int
+	}{
+		{NewPolyInts(1, 2, 3), NewPolyInts(4, 5), nil},
+		{NewPolyInts(7, 0, 0, 2), NewPolyInts(0, 3), nil},
+		{NewPolyInts(5), NewPolyInts(0), q},
+		{NewPolyInts(-5, 3, 1), NewPolyInts(6, 1), q},
+		{RandomPoly(20, 64), RandomPoly(7, 64), q},
+		{RandomPoly(20, 64), RandomPoly(7, 64), nil},
+	}
+	for _, c := range cases {
+		if err := CheckArithmetic(c.p, c.q, c.m); err != nil {
+			t.Errorf("CheckArithmetic(%v, %v, %v): %v", c.p, c.q, c.m, err)
+		}
+	}
+	if err := CheckArithmetic(Poly{nil}, NewPolyInts(1), nil); err == nil {
+		t.Errorf("CheckArithmetic() accepted a nil coefficient")
+	}
+}
@@ -0,0 +1,87 @@
+package polynomial
+
+import "math/big"
+
+// RootInterval is a rational interval (Lo, Hi] that contains exactly one real root
+type RootInterval struct {
+	Lo, Hi *big.Rat
+}
+
+// IsolateRealRoots() returns disjoint intervals, one around every distinct real root of P, in increasing order
+// It bisects (-B, B] with B = RootBound() + 1 and keeps the halves whose Sturm count is 1
+// The Sturm sequence is built from the square-free part, so multiple roots at a midpoint are counted once
+func (p Poly) IsolateRealRoots() []RootInterval {
+	q := p.primitivePart()
+	if q.GetDegree() < 1 {
+		return nil
+	}
+	q = q.squarefree()
+	seq := q.SturmSequence()
+	b := q.RootBound()
+	b.Add(b, big.NewRat(1, 1))
+	var ivs []RootInterval
+	var bisect func(lo, hi *big.Rat, n int)
+	bisect = func(lo, hi *big.Rat, n int) {
+		switch {
+		case n <= 0:
+			return
+		case n == 1:
+			ivs = append(ivs, RootInterval{lo, hi})
+			return
+		}
+		mid := new(big.Rat).Add(lo, hi)
+		mid.Quo(mid, big.NewRat(2, 1))
+		left := countRoots(seq, lo, mid)
+		bisect(lo, mid, left)
+		bisect(mid, hi, n-left)
+	}
+	lo := new(big.Rat).Neg(b)
+	bisect(lo, b, countRoots(seq, lo, b))
+	return ivs
+}
+
+// squarefree() returns P / gcd(P, P') scaled to an integer polynomial, which has the same roots, all simple
+func (p Poly) squarefree() Poly {
+	r := NewRatPoly(p)
+	g := r.Gcd(NewRatPoly(p.Derivative(nil)))
+	q, _ := r.Div(g)
+	s, _ := q.ClearDenominators()
+	return s.primitivePart()
+}
+
+// RefineRoot() narrows an interval from IsolateRealRoots() by bisection and returns its root
+// rounded to prec bits, with an absolute error below 2^-prec max(1, |root|)
+func (p Poly) RefineRoot(iv RootInterval, prec uint) *big.Float {
+	q := p.squarefree()
+	lo, hi := new(big.Rat).Set(iv.Lo), new(big.Rat).Set(iv.Hi)
+	if q.signAt(hi, 0) == 0 {
+		return new(big.Float).SetPrec(prec).SetRat(hi)
+	}
+	// the root is simple, so the sign changes across it
+	shi := q.signAt(hi, 0)
+	eps := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), prec))
+	for {
+		scale := big.NewRat(1, 1)
+		for _, e := range []*big.Rat{lo, hi} {
+			if a := new(big.Rat).Abs(e); a.Cmp(scale) > 0 {
+				scale = a
+			}
+		}
+		width := new(big.Rat).Sub(hi, lo)
+		if width.Cmp(new(big.Rat).Mul(eps, scale)) <= 0 {
+			break
+		}
+		mid := new(big.Rat).Add(lo, hi)
+		mid.Quo(mid, big.NewRat(2, 1))
+		switch s := q.signAt(mid, 0); {
+		case s == 0:
+			return new(big.Float).SetPrec(prec).SetRat(mid)
+		case s == shi:
+			hi = mid
+		default:
+			lo = mid
+		}
+	}
+	mid := new(big.Rat).Add(lo, hi)
+	return new(big.Float).SetPrec(prec).SetRat(mid.Quo(mid, big.NewRat(2, 1)))
+}
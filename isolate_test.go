@@ -0,0 +1,67 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestIsolateRealRoots(t *testing.T) {
+	cases := []struct {
+		p Poly
+		n int
+	}{
+		{NewPolyInts(1, -3, 0, 1), 3},
+		{Wilkinson(6), 6},
+		{SwinnertonDyer(3), 8},
+		{Mignotte(5, big.NewInt(10)), 3},
+		{NewPolyInts(1, 0, 1), 0},
+		{NewPolyInts(-1, 1).Mul(NewPolyInts(-1, 1), nil).Mul(NewPolyInts(0, 1), nil), 2},
+		{NewPolyInts(3), 0},
+		// double roots at the first midpoint 0 and inside an interval
+		{NewPolyInts(0, 0, -1, 0, 1), 3},
+		{NewPolyInts(-1, 1).Mul(NewPolyInts(-1, 1), nil).Mul(NewPolyInts(2, 1), nil), 2},
+	}
+	for _, c := range cases {
+		ivs := c.p.IsolateRealRoots()
+		if len(ivs) != c.n {
+			t.Errorf("IsolateRealRoots(%v) found %d intervals, want %d", c.p, len(ivs), c.n)
+			continue
+		}
+		seq := c.p.SturmSequence()
+		for i, iv := range ivs {
+			if iv.Lo.Cmp(iv.Hi) >= 0 || (i > 0 && ivs[i-1].Hi.Cmp(iv.Lo) > 0) {
+				t.Errorf("IsolateRealRoots(%v) returned overlapping or empty intervals %v", c.p, ivs)
+			}
+			if n := countRoots(seq, iv.Lo, iv.Hi); n != 1 {
+				t.Errorf("(%v, %v] holds %d roots of %v", iv.Lo, iv.Hi, n, c.p)
+			}
+		}
+	}
+}
+
+func TestRefineRoot(t *testing.T) {
+	// x^2 - 2 has roots +-sqrt 2
+	p := NewPolyInts(-2, 0, 1)
+	ivs := p.IsolateRealRoots()
+	if len(ivs) != 2 {
+		t.Fatalf("IsolateRealRoots(%v) = %v", p, ivs)
+	}
+	want := new(big.Float).SetPrec(200).Sqrt(new(big.Float).SetPrec(200).SetInt64(2))
+	got := p.RefineRoot(ivs[1], 100)
+	diff := new(big.Float).Sub(got, want)
+	if diff.Abs(diff).Cmp(new(big.Float).SetMantExp(big.NewFloat(1), -98)) > 0 {
+		t.Errorf("RefineRoot() = %v, want %v", got.Text('g', 30), want.Text('g', 30))
+	}
+	if got := p.RefineRoot(ivs[0], 60); got.Sign() >= 0 {
+		t.Errorf("the first root of %v should be negative, got %v", p, got)
+	}
+
+	// a double root does not change sign, which the square-free part takes care of
+	q := NewPolyInts(-3, 2).Mul(NewPolyInts(-3, 2), nil).Mul(NewPolyInts(1, 1), nil)
+	for _, iv := range q.IsolateRealRoots() {
+		r := q.RefineRoot(iv, 64)
+		if f, _ := r.Float64(); f != 1.5 && f != -1 {
+			t.Errorf("RefineRoot() of %v = %v, want 1.5 or -1", q, r)
+		}
+	}
+}
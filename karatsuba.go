@@ -0,0 +1,86 @@
+package polynomial
+
+import (
+	"math/big"
+	"sync"
+)
+
+// addCoeffs() returns the coefficient-wise sum of two slices without reducing it
+func addCoeffs(a, b []*big.Int) []*big.Int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	r := make([]*big.Int, len(a))
+	for i := range a {
+		r[i] = new(big.Int).Set(a[i])
+		if i < len(b) {
+			r[i].Add(r[i], b[i])
+		}
+	}
+	return r
+}
+
+// karatsuba() returns the untrimmed product of two coefficient slices
+// With A = A0 + x^h A1 and B = B0 + x^h B1, AB = Z0 + x^h (Z1 - Z0 - Z2) + x^2h Z2 where
+// Z0 = A0 B0, Z2 = A1 B1 and Z1 = (A0 + A1)(B0 + B1), so three half-size products replace four
+// Above t.ParallelThreshold coefficients the three products run concurrently
+func karatsuba(a, b []*big.Int, m *big.Int, t Tuning) Poly {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	if len(b) < t.KaratsubaThreshold || len(b) < 2 {
+		return mulSchoolbook(a, b, m, t.EagerReduction)
+	}
+	h := len(a) / 2
+	r := make(Poly, len(a)+len(b)-1)
+	for i := range r {
+		r[i] = new(big.Int)
+	}
+	accumulate := func(z Poly, shift int, sign int) {
+		for i, c := range z {
+			if sign < 0 {
+				r[i+shift].Sub(r[i+shift], c)
+			} else {
+				r[i+shift].Add(r[i+shift], c)
+			}
+		}
+	}
+	if len(b) <= h {
+		// B is short: split A only
+		accumulate(karatsuba(a[:h], b, m, t), 0, 1)
+		accumulate(karatsuba(a[h:], b, m, t), h, 1)
+	} else {
+		var z0, z1, z2 Poly
+		products := []func(){
+			func() { z0 = karatsuba(a[:h], b[:h], m, t) },
+			func() { z2 = karatsuba(a[h:], b[h:], m, t) },
+			func() { z1 = karatsuba(addCoeffs(a[:h], a[h:]), addCoeffs(b[:h], b[h:]), m, t) },
+		}
+		if t.ParallelThreshold > 0 && len(a) >= t.ParallelThreshold {
+			var wg sync.WaitGroup
+			for _, f := range products {
+				wg.Add(1)
+				go func(f func()) {
+					defer wg.Done()
+					f()
+				}(f)
+			}
+			wg.Wait()
+		} else {
+			for _, f := range products {
+				f()
+			}
+		}
+		accumulate(z0, 0, 1)
+		accumulate(z1, h, 1)
+		accumulate(z0, h, -1)
+		accumulate(z2, h, -1)
+		accumulate(z2, 2*h, 1)
+	}
+	if m != nil {
+		for _, c := range r {
+			c.Mod(c, m)
+		}
+	}
+	return r
+}
@@ -0,0 +1,32 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestKaratsuba(t *testing.T) {
+	m := big.NewInt(1000000007)
+	cases := []struct {
+		la, lb int
+		m      *big.Int
+		t      Tuning
+	}{
+		{1, 1, nil, Tuning{KaratsubaThreshold: 2}},
+		{7, 3, nil, Tuning{KaratsubaThreshold: 2}},
+		{64, 64, m, Tuning{KaratsubaThreshold: 4}},
+		{100, 37, m, Tuning{KaratsubaThreshold: 8}},
+		{130, 129, nil, Tuning{KaratsubaThreshold: 2, ParallelThreshold: 16}},
+		{257, 3, m, Tuning{KaratsubaThreshold: 2, ParallelThreshold: 8}},
+	}
+	for _, c := range cases {
+		p, q := RandomPoly(int64(c.la-1), 64), RandomPoly(int64(c.lb-1), 64)
+		want := mulSchoolbook(p, q, c.m, true)
+		want.trim()
+		got := karatsuba(p, q, c.m, c.t)
+		got.trim()
+		if got.Compare(&want) != 0 {
+			t.Errorf("karatsuba() of sizes %d x %d with %+v differs from schoolbook", c.la, c.lb, c.t)
+		}
+	}
+}
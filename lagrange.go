@@ -43,3 +43,44 @@ func (ps Points) Lagrange(m *big.Int) (lag Poly) {
 	lag.trim()
 	return
 }
+
+// lagrangeBasis() returns Z(x) = (x - xs[0])...(x - xs[n-1]) and all L_j(x) with L_j(xs[k]) = [j == k]
+// Z comes from a product tree and L_j = Z / ((x - xs[j]) Z'(xs[j])), so no pairwise differences are needed
+func lagrangeBasis(xs []*big.Int, m *big.Int) (z Poly, basis []Poly) {
+	ps := make([]Poly, len(xs))
+	for i, x := range xs {
+		ps[i] = xMinusConst(x)
+	}
+	z = product(ps, m)
+	dz := z.Derivative(m)
+	basis = make([]Poly, len(xs))
+	for j := range xs {
+		l, _ := z.Clone(0).Div(xMinusConst(xs[j]), m)
+		w := new(big.Int).ModInverse(dz.Eval(xs[j], m), m)
+		basis[j] = l.Mul(Poly{w}, m)
+	}
+	return
+}
+
+// LagrangeBasis() returns L_i(x) = prod over j != i of (x - xs[j]) / (xs[i] - xs[j])
+// The points must be distinct modulo a prime m
+func LagrangeBasis(xs []*big.Int, i int, m *big.Int) Poly {
+	ps := make([]Poly, 0, len(xs))
+	w := big.NewInt(1)
+	for j, x := range xs {
+		if j == i {
+			continue
+		}
+		ps = append(ps, xMinusConst(x))
+		w.Mul(w, new(big.Int).Sub(xs[i], x))
+		w.Mod(w, m)
+	}
+	return product(ps, m).Mul(Poly{w.ModInverse(w, m)}, m)
+}
+
+// LagrangeBases() returns every L_i(x) for the points xs, sharing one product tree
+// The points must be distinct modulo a prime m
+func LagrangeBases(xs []*big.Int, m *big.Int) []Poly {
+	_, basis := lagrangeBasis(xs, m)
+	return basis
+}
@@ -95,3 +95,40 @@ func TestLagrange(t *testing.T) {
 		}
 	}
 }
+
+func TestLagrangeBasis(t *testing.T) {
+	m := big.NewInt(101)
+	cases := []struct {
+		xs []*big.Int
+	}{
+		{bigs(5)},
+		{bigs(1, 2, 3)},
+		{bigs(0, 7, 33, 99, 100, 4)},
+	}
+	for _, c := range cases {
+		bases := LagrangeBases(c.xs, m)
+		for i := range c.xs {
+			l := LagrangeBasis(c.xs, i, m)
+			if l.Compare(&bases[i]) != 0 {
+				t.Errorf("LagrangeBasis(%v, %d) = %v, but LagrangeBases() has %v", c.xs, i, l, bases[i])
+			}
+			if l.GetDegree() != len(c.xs)-1 {
+				t.Errorf("LagrangeBasis(%v, %d) = %v has the wrong degree", c.xs, i, l)
+			}
+			for k, x := range c.xs {
+				want := int64(0)
+				if k == i {
+					want = 1
+				}
+				if y := l.Eval(x, m); y.Int64() != want {
+					t.Errorf("L_%d(%v) = %v, want %d", i, x, y, want)
+				}
+			}
+		}
+	}
+	// L_0 = (x - 2) / (1 - 2) = 2 - x over {1, 2}
+	got := LagrangeBasis(bigs(1, 2), 0, m)
+	if want := NewPolyInts(2, 100); got.Compare(&want) != 0 {
+		t.Errorf("LagrangeBasis({1, 2}, 0) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,163 @@
+package polynomial
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// LaurentPoly represents x^Low * P(x) where Low can be negative
+// A normalized LaurentPoly has a non-zero constant term in P (unless it is zero),
+// i.e. the common power x^k is factored out into Low
+type LaurentPoly struct {
+	Low int
+	P   Poly
+}
+
+var errLaurentEval = errors.New("polynomial: negative powers of x are not integers without a modulus")
+
+// NewLaurentPoly() returns x^low * P and normalizes it
+func NewLaurentPoly(low int, p Poly) LaurentPoly {
+	l := LaurentPoly{low, p.Clone(0)}
+	l.Normalize()
+	return l
+}
+
+// Normalize() trims P and moves the lowest zero coefficients of P into Low
+// An empty P, as in the zero value LaurentPoly{}, is the zero polynomial
+func (l *LaurentPoly) Normalize() {
+	if len(l.P) == 0 {
+		l.P = NewPolyInts(0)
+	}
+	l.P.trim()
+	if l.P.isZero() {
+		l.Low = 0
+		return
+	}
+	k := 0
+	for l.P[k].Sign() == 0 {
+		k++
+	}
+	if k > 0 {
+		l.P = l.P[k:]
+		l.Low += k
+	}
+}
+
+// LowDegree() and HighDegree() return the lowest and the highest exponent
+func (l LaurentPoly) LowDegree() int {
+	return l.Low
+}
+
+func (l LaurentPoly) HighDegree() int {
+	return l.Low + l.P.GetDegree()
+}
+
+// ToPoly() returns the ordinary polynomial if there is no negative exponent
+func (l LaurentPoly) ToPoly() (Poly, bool) {
+	if l.Low < 0 {
+		return nil, false
+	}
+	return l.P.Clone(l.Low), true
+}
+
+// align() returns both P parts shifted to the same lowest exponent
+func (l LaurentPoly) align(k LaurentPoly) (low int, a, b Poly) {
+	low = l.Low
+	if k.Low < low {
+		low = k.Low
+	}
+	return low, l.P.Clone(l.Low - low), k.P.Clone(k.Low - low)
+}
+
+// Add() adds two Laurent polynomials
+// modulo m can be nil
+func (l LaurentPoly) Add(k LaurentPoly, m *big.Int) LaurentPoly {
+	low, a, b := l.align(k)
+	r := LaurentPoly{low, a.Add(b, m)}
+	r.Normalize()
+	return r
+}
+
+// Sub() subtracts k from l
+func (l LaurentPoly) Sub(k LaurentPoly, m *big.Int) LaurentPoly {
+	low, a, b := l.align(k)
+	r := LaurentPoly{low, a.Sub(b, m)}
+	r.Normalize()
+	return r
+}
+
+// Mul() multiplies two Laurent polynomials
+func (l LaurentPoly) Mul(k LaurentPoly, m *big.Int) LaurentPoly {
+	r := LaurentPoly{l.Low + k.Low, l.P.Clone(0).Mul(k.P.Clone(0), m)}
+	r.Normalize()
+	return r
+}
+
+// Eval() returns l(x)
+// Without a modulus, x^Low for a negative Low is only an integer when x = 1 or -1
+func (l LaurentPoly) Eval(x, m *big.Int) (*big.Int, error) {
+	y := l.P.Eval(x, m)
+	if l.Low >= 0 {
+		xe := new(big.Int).Exp(x, big.NewInt(int64(l.Low)), m)
+		y.Mul(y, xe)
+		if m != nil {
+			y.Mod(y, m)
+		}
+		return y, nil
+	}
+	var inv *big.Int
+	if m != nil {
+		inv = new(big.Int).ModInverse(x, m)
+	} else if x.CmpAbs(big.NewInt(1)) == 0 {
+		inv = new(big.Int).Set(x)
+	}
+	if inv == nil {
+		return nil, errLaurentEval
+	}
+	xe := new(big.Int).Exp(inv, big.NewInt(int64(-l.Low)), m)
+	y.Mul(y, xe)
+	if m != nil {
+		y.Mod(y, m)
+	}
+	return y, nil
+}
+
+// pretty print, e.g. [3x + 2 - x^-2]
+func (l LaurentPoly) String() string {
+	if l.P.isZero() {
+		return "[0]"
+	}
+	var terms []string
+	for i := len(l.P) - 1; i >= 0; i-- {
+		c := l.P[i]
+		if c.Sign() == 0 {
+			continue
+		}
+		e := l.Low + i
+		abs := new(big.Int).Abs(c)
+		var s string
+		switch {
+		case e == 0:
+			s = abs.String()
+		case abs.Cmp(big.NewInt(1)) == 0:
+			s = "x"
+		default:
+			s = abs.String() + "x"
+		}
+		if e != 0 && e != 1 {
+			s += fmt.Sprintf("^%d", e)
+		}
+		switch {
+		case len(terms) == 0 && c.Sign() < 0:
+			s = "-" + s
+		case len(terms) > 0 && c.Sign() < 0:
+			s = "- " + s
+		case len(terms) > 0:
+			s = "+ " + s
+		}
+		terms = append(terms, s)
+	}
+	return "[" + strings.Join(terms, " ") + "]"
+}
@@ -0,0 +1,74 @@
+package polynomial
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestLaurentNormalize(t *testing.T) {
+	cases := []struct {
+		l   LaurentPoly
+		low int
+		ans Poly
+		s   string
+	}{
+		{NewLaurentPoly(-2, NewPolyInts(0, 0, 3, 1)), 0, NewPolyInts(3, 1), "[x + 3]"},
+		{NewLaurentPoly(-3, NewPolyInts(-1, 0, 2)), -3, NewPolyInts(-1, 0, 2), "[2x^-1 - x^-3]"},
+		{NewLaurentPoly(5, NewPolyInts(0)), 0, NewPolyInts(0), "[0]"},
+		{NewLaurentPoly(0, NewPolyInts(0, 4)), 1, NewPolyInts(4), "[4x]"},
+		// the zero value and an empty P are zero
+		{func() LaurentPoly { var z LaurentPoly; z.Normalize(); return z }(), 0, NewPolyInts(0), "[0]"},
+		{NewLaurentPoly(3, nil), 0, NewPolyInts(0), "[0]"},
+	}
+	for _, c := range cases {
+		if c.l.Low != c.low || c.l.P.Compare(&c.ans) != 0 {
+			t.Errorf("normalized form is x^%v * %v, want x^%v * %v", c.l.Low, c.l.P, c.low, c.ans)
+		}
+		if s := fmt.Sprintf("%v", c.l); s != c.s {
+			t.Errorf("Stringify %v should be %v", s, c.s)
+		}
+	}
+}
+
+func TestLaurentArithmetic(t *testing.T) {
+	a := NewLaurentPoly(-1, NewPolyInts(1, 0, 1))    // x^-1 + x
+	b := NewLaurentPoly(-2, NewPolyInts(2, 0, 0, 1)) // 2x^-2 + x
+	sum := a.Add(b, nil)
+	if sum.Low != -2 || sum.P.Compare(&Poly{big.NewInt(2), big.NewInt(1), big.NewInt(0), big.NewInt(2)}) != 0 {
+		t.Errorf("%v + %v = %v", a, b, sum)
+	}
+	diff := a.Sub(a, nil)
+	if diff.Low != 0 || !diff.P.isZero() {
+		t.Errorf("%v - %v = %v", a, a, diff)
+	}
+	// (x^-1 + x)(x^-1 - x) = x^-2 - x^2
+	c := NewLaurentPoly(-1, NewPolyInts(1, 0, -1))
+	prod := a.Mul(c, nil)
+	if prod.Low != -2 || prod.P.Compare(&Poly{big.NewInt(1), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(-1)}) != 0 {
+		t.Errorf("%v * %v = %v", a, c, prod)
+	}
+	if _, ok := prod.ToPoly(); ok {
+		t.Errorf("%v should not convert to an ordinary polynomial", prod)
+	}
+	p, ok := NewLaurentPoly(2, NewPolyInts(1, 1)).ToPoly()
+	if ans := NewPolyInts(0, 0, 1, 1); !ok || p.Compare(&ans) != 0 {
+		t.Errorf("ToPoly() = %v, want %v", p, ans)
+	}
+}
+
+func TestLaurentEval(t *testing.T) {
+	m := big.NewInt(11)
+	a := NewLaurentPoly(-1, NewPolyInts(1, 0, 1)) // x^-1 + x
+	v, err := a.Eval(big.NewInt(2), m)
+	// 2^-1 + 2 = 6 + 2 = 8 mod 11
+	if err != nil || v.Int64() != 8 {
+		t.Errorf("%v at 2 (mod 11) = %v, want 8 (error: %v)", a, v, err)
+	}
+	if v, err := a.Eval(big.NewInt(-1), nil); err != nil || v.Int64() != -2 {
+		t.Errorf("%v at -1 = %v, want -2 (error: %v)", a, v, err)
+	}
+	if _, err := a.Eval(big.NewInt(2), nil); err == nil {
+		t.Errorf("%v at 2 without a modulus should fail", a)
+	}
+}
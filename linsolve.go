@@ -0,0 +1,63 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errCompositeModulus = errors.New("polynomial: no pivot is invertible, so the modulus is composite")
+
+// SolveLinearSystem() returns x with A x = b for a square non-singular A modulo m by Gaussian elimination
+// Each column pivots on the first row whose entry is invertible modulo m; if the column is zero below the
+// diagonal A is singular (errSingular), and if it only holds zero divisors m is composite (errCompositeModulus)
+func SolveLinearSystem(a [][]*big.Int, b []*big.Int, m *big.Int) ([]*big.Int, error) {
+	if m == nil || m.Sign() <= 0 {
+		return nil, errBadModulus
+	}
+	if !isSquare(a) || len(b) != len(a) {
+		return nil, errMatrixShape
+	}
+	n := len(a)
+	// the augmented matrix [A | b]
+	aug := make([][]*big.Int, n)
+	for i := range aug {
+		aug[i] = append(copyMatrix(a[i:i+1], m)[0], new(big.Int).Mod(b[i], m))
+	}
+	t := new(big.Int)
+	for j := 0; j < n; j++ {
+		piv, inv, zero := -1, new(big.Int), true
+		for i := j; i < n && piv < 0; i++ {
+			if aug[i][j].Sign() == 0 {
+				continue
+			}
+			zero = false
+			if inv.ModInverse(aug[i][j], m) != nil {
+				piv = i
+			}
+		}
+		switch {
+		case zero:
+			return nil, errSingular
+		case piv < 0:
+			return nil, errCompositeModulus
+		}
+		aug[j], aug[piv] = aug[piv], aug[j]
+		for k := j; k <= n; k++ {
+			aug[j][k].Mul(aug[j][k], inv).Mod(aug[j][k], m)
+		}
+		for i := range aug {
+			if i == j || aug[i][j].Sign() == 0 {
+				continue
+			}
+			c := new(big.Int).Set(aug[i][j])
+			for k := j; k <= n; k++ {
+				aug[i][k].Sub(aug[i][k], t.Mul(c, aug[j][k])).Mod(aug[i][k], m)
+			}
+		}
+	}
+	x := make([]*big.Int, n)
+	for i := range x {
+		x[i] = aug[i][n]
+	}
+	return x, nil
+}
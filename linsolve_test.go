@@ -0,0 +1,58 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSolveLinearSystem(t *testing.T) {
+	cases := []struct {
+		a    [][]*big.Int
+		b    []int64
+		m    int64
+		want []int64
+		err  error
+	}{
+		// x + 2y = 5, 3x + 4y = 6 mod 7: x = 3, y = 1
+		{intMatrix([]int64{1, 2}, []int64{3, 4}), []int64{5, 6}, 7, []int64{3, 1}, nil},
+		// the first pivot is zero and needs a row swap
+		{intMatrix([]int64{0, 1}, []int64{1, 0}), []int64{4, 9}, 11, []int64{9, 4}, nil},
+		{intMatrix(), nil, 11, []int64{}, nil},
+		{intMatrix([]int64{1, 2}, []int64{2, 4}), []int64{1, 2}, 7, nil, errSingular},
+		// mod 6 the pivot candidates 2 and 3 are zero divisors
+		{intMatrix([]int64{2, 1}, []int64{3, 1}), []int64{1, 1}, 6, nil, errCompositeModulus},
+		// mod 6 a unit pivot is still found when one exists
+		{intMatrix([]int64{2, 1}, []int64{5, 3}), []int64{1, 2}, 6, []int64{1, 5}, nil},
+		{intMatrix([]int64{2, 1}), []int64{1}, 7, nil, errMatrixShape},
+	}
+	for _, c := range cases {
+		b := make([]*big.Int, len(c.b))
+		for i, v := range c.b {
+			b[i] = big.NewInt(v)
+		}
+		x, err := SolveLinearSystem(c.a, b, big.NewInt(c.m))
+		if err != c.err {
+			t.Errorf("SolveLinearSystem(%v, %v) mod %d: error %v, expected %v", c.a, c.b, c.m, err, c.err)
+			continue
+		}
+		for i := range c.want {
+			if x[i].Int64() != c.want[i] {
+				t.Errorf("SolveLinearSystem(%v, %v) mod %d = %v, expected %v", c.a, c.b, c.m, x, c.want)
+				break
+			}
+		}
+	}
+
+	// interpolation agrees with SolveVandermonde
+	m := big.NewInt(998244353)
+	xs := []*big.Int{big.NewInt(3), big.NewInt(-1), big.NewInt(10), big.NewInt(8)}
+	ys := []*big.Int{big.NewInt(1), big.NewInt(4), big.NewInt(1), big.NewInt(5)}
+	want, _ := SolveVandermonde(xs, ys, m)
+	got, err := SolveLinearSystem(BuildVandermonde(xs, m), ys, m)
+	if err != nil || !equalVectors(got, want) {
+		t.Errorf("SolveLinearSystem(Vandermonde) = %v, %v, expected %v", got, err, want)
+	}
+	if _, err := SolveLinearSystem(intMatrix([]int64{1}), []*big.Int{big.NewInt(1)}, nil); err != errBadModulus {
+		t.Errorf("no modulus: error %v, expected errBadModulus", err)
+	}
+}
@@ -0,0 +1,30 @@
+package polynomial
+
+import "math/big"
+
+// Map() returns a new polynomial whose i-th coefficient is f(i, P[i])
+// f receives a copy of the coefficient, so it may modify and return it
+// A nil result counts as zero, and the result is trimmed
+func (p Poly) Map(f func(i int, c *big.Int) *big.Int) Poly {
+	q := make(Poly, len(p))
+	for i := range p {
+		v := f(i, p.Coeff(i))
+		if v == nil {
+			v = big.NewInt(0)
+		}
+		q[i] = v
+	}
+	if len(q) == 0 {
+		return NewPolyInts(0)
+	}
+	q.trim()
+	return q
+}
+
+// ForEach() calls f(i, P[i]) for every coefficient from the constant term up to the degree
+// f receives a copy of the coefficient
+func (p Poly) ForEach(f func(i int, c *big.Int)) {
+	for i := range p {
+		f(i, p.Coeff(i))
+	}
+}
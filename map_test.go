@@ -0,0 +1,72 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	m := big.NewInt(11)
+	half := new(big.Int).Rsh(m, 1)
+	cases := []struct {
+		p   Poly
+		f   func(i int, c *big.Int) *big.Int
+		ans Poly
+	}{
+		{
+			// reduction
+			NewPolyInts(12, -1, 22),
+			func(i int, c *big.Int) *big.Int { return c.Mod(c, m) },
+			NewPolyInts(1, 10),
+		},
+		{
+			// scaling by the exponent (formal derivative shifted by one)
+			NewPolyInts(5, 4, 3, 2),
+			func(i int, c *big.Int) *big.Int { return c.Mul(c, big.NewInt(int64(i))) },
+			NewPolyInts(0, 4, 6, 6),
+		},
+		{
+			// lifting to the centered form (-m/2, m/2]
+			NewPolyInts(1, 6, 10, 5),
+			func(i int, c *big.Int) *big.Int {
+				if c.Cmp(half) > 0 {
+					c.Sub(c, m)
+				}
+				return c
+			},
+			NewPolyInts(1, -5, -1, 5),
+		},
+		{
+			NewPolyInts(1, 2, 3),
+			func(i int, c *big.Int) *big.Int { return nil },
+			NewPolyInts(0),
+		},
+	}
+	for _, c := range cases {
+		tmp := c.p.Clone(0)
+		res := c.p.Map(c.f)
+		if res.Compare(&c.ans) != 0 {
+			t.Errorf("Map over %v gives %v, want %v", tmp, res, c.ans)
+		}
+		if c.p.Compare(&tmp) != 0 {
+			t.Errorf("Map() modified the original polynomial %v into %v", tmp, c.p)
+		}
+	}
+}
+
+func TestForEach(t *testing.T) {
+	p := NewPolyInts(5, -4, 0, 3)
+	sum := big.NewInt(0)
+	n := 0
+	p.ForEach(func(i int, c *big.Int) {
+		sum.Add(sum, c)
+		c.SetInt64(0)
+		n++
+	})
+	if sum.Int64() != 4 || n != 4 {
+		t.Errorf("ForEach over %v visits %v coefficients summing to %v", p, n, sum)
+	}
+	if p[0].Int64() != 5 {
+		t.Errorf("ForEach() should pass copies of the coefficients")
+	}
+}
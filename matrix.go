@@ -0,0 +1,87 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errNegativeExponent = errors.New("polynomial: the exponent must not be negative")
+
+// identityMatrix() returns the n x n identity matrix
+func identityMatrix(n int) [][]*big.Int {
+	a := make([][]*big.Int, n)
+	for i := range a {
+		a[i] = make([]*big.Int, n)
+		for j := range a[i] {
+			a[i][j] = new(big.Int)
+		}
+		a[i][i].SetInt64(1)
+	}
+	return a
+}
+
+// isSquare() checks that a has as many columns in every row as it has rows
+func isSquare(a [][]*big.Int) bool {
+	for _, row := range a {
+		if len(row) != len(a) {
+			return false
+		}
+	}
+	return true
+}
+
+// matMul() returns A B for compatible dimensions; modulo m can be nil
+func matMul(a, b [][]*big.Int, m *big.Int) [][]*big.Int {
+	r := make([][]*big.Int, len(a))
+	t := new(big.Int)
+	for i := range a {
+		r[i] = make([]*big.Int, len(b[0]))
+		for j := range r[i] {
+			s := new(big.Int)
+			for k := range b {
+				s.Add(s, t.Mul(a[i][k], b[k][j]))
+			}
+			if m != nil {
+				s.Mod(s, m)
+			}
+			r[i][j] = s
+		}
+	}
+	return r
+}
+
+// matVec() returns A v; modulo m can be nil
+func matVec(a [][]*big.Int, v []*big.Int, m *big.Int) []*big.Int {
+	r := make([]*big.Int, len(a))
+	t := new(big.Int)
+	for i, row := range a {
+		s := new(big.Int)
+		for j, c := range row {
+			s.Add(s, t.Mul(c, v[j]))
+		}
+		if m != nil {
+			s.Mod(s, m)
+		}
+		r[i] = s
+	}
+	return r
+}
+
+// MatrixPower() returns A^e for a square matrix A and e >= 0 by repeated squaring
+// modulo m can be nil
+func MatrixPower(a [][]*big.Int, e *big.Int, m *big.Int) ([][]*big.Int, error) {
+	if !isSquare(a) {
+		return nil, errMatrixShape
+	}
+	if e.Sign() < 0 {
+		return nil, errNegativeExponent
+	}
+	r := identityMatrix(len(a))
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r = matMul(r, r, m)
+		if e.Bit(i) == 1 {
+			r = matMul(r, a, m)
+		}
+	}
+	return r, nil
+}
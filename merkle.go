@@ -0,0 +1,116 @@
+package polynomial
+
+import (
+	"bytes"
+	"errors"
+	"hash"
+	"math/big"
+)
+
+// MerkleCommitment commits to the evaluations of a polynomial over a domain with a Merkle tree
+// Leaves are H(0x00 || sign || len || |y|) and inner nodes are H(0x01 || left || right)
+// The tree is padded with empty leaves H(0x02) up to a power of two, which no evaluation hashes to
+type MerkleCommitment struct {
+	Domain []*big.Int
+	Evals  []*big.Int
+	levels [][][]byte // levels[0] are the leaves, the last level is the root
+}
+
+// MerkleProof proves that the polynomial evaluates to Value at Domain[Index]
+type MerkleProof struct {
+	Index    int
+	Value    *big.Int
+	Siblings [][]byte
+}
+
+var errMerkleEmpty = errors.New("polynomial: cannot build a Merkle tree over an empty domain")
+
+func merkleLeaf(h hash.Hash, v *big.Int) []byte {
+	h.Reset()
+	// the sign byte keeps -y and y apart when the evaluations are not reduced
+	h.Write(appendBigInt([]byte{0}, v))
+	return h.Sum(nil)
+}
+
+func merkleEmpty(h hash.Hash) []byte {
+	h.Reset()
+	h.Write([]byte{2})
+	return h.Sum(nil)
+}
+
+func merkleNode(h hash.Hash, l, r []byte) []byte {
+	h.Reset()
+	h.Write([]byte{1})
+	h.Write(l)
+	h.Write(r)
+	return h.Sum(nil)
+}
+
+// NewMerkleCommitment() evaluates p over the domain (modulo m, which can be nil) and builds the tree
+// h is reset before every use, so the same hash.Hash can be shared with VerifyMerkleProof()
+func (p Poly) NewMerkleCommitment(domain []*big.Int, h hash.Hash, m *big.Int) (*MerkleCommitment, error) {
+	if len(domain) == 0 {
+		return nil, errMerkleEmpty
+	}
+	mc := &MerkleCommitment{Domain: domain, Evals: make([]*big.Int, len(domain))}
+	for i, x := range domain {
+		mc.Evals[i] = p.Eval(x, m)
+	}
+	size := 1
+	for size < len(domain) {
+		size <<= 1
+	}
+	leaves := make([][]byte, size)
+	empty := merkleEmpty(h)
+	for i := range leaves {
+		if i < len(mc.Evals) {
+			leaves[i] = merkleLeaf(h, mc.Evals[i])
+		} else {
+			leaves[i] = empty
+		}
+	}
+	mc.levels = [][][]byte{leaves}
+	for cur := leaves; len(cur) > 1; {
+		next := make([][]byte, len(cur)/2)
+		for i := range next {
+			next[i] = merkleNode(h, cur[2*i], cur[2*i+1])
+		}
+		mc.levels = append(mc.levels, next)
+		cur = next
+	}
+	return mc, nil
+}
+
+// Root() returns the Merkle root
+func (mc *MerkleCommitment) Root() []byte {
+	return mc.levels[len(mc.levels)-1][0]
+}
+
+// Prove() returns the authentication path of the evaluation at Domain[i]
+func (mc *MerkleCommitment) Prove(i int) MerkleProof {
+	pf := MerkleProof{Index: i, Value: mc.Evals[i]}
+	for l := 0; l < len(mc.levels)-1; l++ {
+		pf.Siblings = append(pf.Siblings, mc.levels[l][i^1])
+		i >>= 1
+	}
+	return pf
+}
+
+// VerifyMerkleProof() checks the proof against the root
+// A proof at a padding index cannot verify, since padding leaves are not hashes of values
+func VerifyMerkleProof(root []byte, pf MerkleProof, h hash.Hash) bool {
+	if pf.Index < 0 || pf.Index >= 1<<uint(len(pf.Siblings)) {
+		return false
+	}
+	cur := merkleLeaf(h, pf.Value)
+	i := pf.Index
+	for _, s := range pf.Siblings {
+		if i&1 == 0 {
+			cur = merkleNode(h, cur, s)
+		} else {
+			cur = merkleNode(h, s, cur)
+		}
+		i >>= 1
+	}
+	return bytes.Equal(cur, root)
+}
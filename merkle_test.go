@@ -0,0 +1,55 @@
+package polynomial
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+func TestMerkleCommitment(t *testing.T) {
+	m := big.NewInt(311)
+	p := NewPolyInts(43, 53, 45, 63, 43, 55, 75)
+	for _, n := range []int{1, 2, 5, 8, 13} {
+		domain := make([]*big.Int, n)
+		for i := range domain {
+			domain[i] = big.NewInt(int64(i + 11))
+		}
+		mc, err := p.NewMerkleCommitment(domain, sha256.New(), m)
+		if err != nil {
+			t.Fatalf("NewMerkleCommitment() over %v points failed: %v", n, err)
+		}
+		for i := 0; i < n; i++ {
+			pf := mc.Prove(i)
+			if pf.Value.Cmp(p.Eval(domain[i], m)) != 0 {
+				t.Errorf("Prove(%v) opens %v, want %v", i, pf.Value, p.Eval(domain[i], m))
+			}
+			if !VerifyMerkleProof(mc.Root(), pf, sha256.New()) {
+				t.Errorf("a valid proof at %v (of %v points) does not verify", i, n)
+			}
+			pf.Value = new(big.Int).Add(pf.Value, big.NewInt(1))
+			if VerifyMerkleProof(mc.Root(), pf, sha256.New()) {
+				t.Errorf("a tampered proof at %v (of %v points) verifies", i, n)
+			}
+		}
+	}
+	// P(x) = 0 must not be provable at a padding index
+	domain := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4), big.NewInt(5)}
+	mc, _ := p.NewMerkleCommitment(domain, sha256.New(), m)
+	forged := MerkleProof{Index: 6, Value: big.NewInt(0)}
+	for l, i := 0, 6; l < len(mc.levels)-1; l, i = l+1, i>>1 {
+		forged.Siblings = append(forged.Siblings, mc.levels[l][i^1])
+	}
+	if VerifyMerkleProof(mc.Root(), forged, sha256.New()) {
+		t.Errorf("a proof at a padding index verifies")
+	}
+	// without a modulus the evaluations keep their sign
+	neg, _ := NewPolyInts(-5).NewMerkleCommitment(domain, sha256.New(), nil)
+	pf := neg.Prove(0)
+	pf.Value = big.NewInt(5)
+	if VerifyMerkleProof(neg.Root(), pf, sha256.New()) {
+		t.Errorf("a proof of 5 verifies against the evaluations of -5")
+	}
+	if _, err := p.NewMerkleCommitment(nil, sha256.New(), m); err == nil {
+		t.Errorf("NewMerkleCommitment() over an empty domain should fail")
+	}
+}
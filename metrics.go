@@ -0,0 +1,237 @@
+package polynomial
+
+import (
+	"encoding/json"
+	"math/big"
+	"math/bits"
+	"runtime/metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// OpStats describes one instrumented call of Mul, Div or Eval
+// Muls and Mods count the big.Int multiplications and reductions the algorithm performs
+// Allocs is the process-wide growth of heap objects during the call, so it is approximate under concurrency
+type OpStats struct {
+	Op       string
+	Muls     int64
+	Mods     int64
+	Allocs   int64
+	Duration time.Duration
+}
+
+// MetricsHook receives an OpStats for every instrumented call
+// Record may be called concurrently
+type MetricsHook interface {
+	Record(s OpStats)
+}
+
+type hookHolder struct {
+	h MetricsHook
+}
+
+var hook atomic.Value
+
+func init() {
+	hook.Store(hookHolder{})
+}
+
+// SetMetricsHook() installs h for every later operation; nil disables instrumentation
+// Without a hook the instrumented operations only pay for one atomic load
+func SetMetricsHook(h MetricsHook) {
+	hook.Store(hookHolder{h})
+}
+
+// metricsHook() returns the installed hook or nil
+func metricsHook() *hookHolder {
+	hh := hook.Load().(hookHolder)
+	if hh.h == nil {
+		return nil
+	}
+	return &hh
+}
+
+// opCost holds the analytic operation counts of one call
+type opCost struct {
+	muls, mods int64
+}
+
+func (hh *hookHolder) record(op string, c opCost, start time.Time, allocs int64) {
+	hh.h.Record(OpStats{
+		Op:       op,
+		Muls:     c.muls,
+		Mods:     c.mods,
+		Allocs:   heapObjects() - allocs,
+		Duration: time.Since(start),
+	})
+}
+
+var heapSample = []metrics.Sample{{Name: "/gc/heap/allocs:objects"}}
+var heapSampleMu sync.Mutex
+
+// heapObjects() returns the cumulative number of heap objects allocated by the process
+func heapObjects() int64 {
+	heapSampleMu.Lock()
+	defer heapSampleMu.Unlock()
+	metrics.Read(heapSample)
+	if heapSample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return int64(heapSample[0].Value.Uint64())
+}
+
+// mulCost() mirrors the dispatch of Mul and karatsuba() on operands of la and lb coefficients
+func mulCost(la, lb int, m *big.Int, t Tuning) opCost {
+	if useNTT(la, lb, m, t) {
+		if n, w := nttPlan(la, lb, m); w != nil {
+			return nttCost(n)
+		}
+	}
+	if t.KaratsubaThreshold > 0 && la >= t.KaratsubaThreshold && lb >= t.KaratsubaThreshold {
+		return karatsubaCost(la, lb, m, t)
+	}
+	return schoolbookCost(la, lb, m, t.EagerReduction)
+}
+
+// nttCost() counts the three transforms of n/2 log n butterflies (two products and four reductions each), the
+// pointwise products, the scaling by 1/n and the reduction of the inputs
+func nttCost(n int) opCost {
+	bf := int64(3 * n / 2 * bits.Len(uint(n-1)))
+	return opCost{muls: 2*bf + 2*int64(n), mods: 4*bf + 4*int64(n)}
+}
+
+func schoolbookCost(la, lb int, m *big.Int, eager bool) opCost {
+	c := opCost{muls: int64(la) * int64(lb)}
+	switch {
+	case m == nil:
+	case eager:
+		c.mods = c.muls
+	default:
+		for k := 0; k < la+lb-1; k++ {
+			lo, hi := k-lb+1, k
+			if lo < 0 {
+				lo = 0
+			}
+			if hi > la-1 {
+				hi = la - 1
+			}
+			c.mods += deferredMods(hi - lo + 1)
+		}
+	}
+	return c
+}
+
+func karatsubaCost(la, lb int, m *big.Int, t Tuning) opCost {
+	if la < lb {
+		la, lb = lb, la
+	}
+	if lb < t.KaratsubaThreshold || lb < 2 {
+		return schoolbookCost(la, lb, m, t.EagerReduction)
+	}
+	h := la / 2
+	var c opCost
+	add := func(d opCost) {
+		c.muls += d.muls
+		c.mods += d.mods
+	}
+	if lb <= h {
+		add(karatsubaCost(h, lb, m, t))
+		add(karatsubaCost(la-h, lb, m, t))
+	} else {
+		add(karatsubaCost(h, h, m, t))
+		add(karatsubaCost(la-h, lb-h, m, t))
+		add(karatsubaCost(la-h, h, m, t))
+	}
+	if m != nil {
+		c.mods += int64(la + lb - 1)
+	}
+	return c
+}
+
+// divCost() bounds the work of long division of la coefficients by lb coefficients
+// Each quotient coefficient costs one leading-term multiplication and lb products
+func divCost(la, lb int, m *big.Int) opCost {
+	if lb == 0 || la < lb {
+		return opCost{}
+	}
+	c := opCost{muls: int64(la-lb+1) * int64(lb+1)}
+	if m != nil {
+		c.mods = c.muls
+	}
+	return c
+}
+
+// evalCost() counts the work of Eval on la coefficients: one product with the coefficient and one power of x each
+func evalCost(la int, m *big.Int, eager bool) opCost {
+	c := opCost{muls: 2 * int64(la)}
+	switch {
+	case m == nil:
+	case eager:
+		c.mods = 2 * int64(la)
+	default:
+		c.mods = int64(la) + deferredMods(la)
+	}
+	return c
+}
+
+// Counters is a MetricsHook aggregating OpStats per operation
+// It implements expvar.Var, so it can be exported with expvar.Publish("polynomial", c)
+type Counters struct {
+	mu  sync.Mutex
+	ops map[string]OpTotals
+}
+
+// OpTotals accumulates the OpStats of one operation
+type OpTotals struct {
+	Calls    int64
+	Muls     int64
+	Mods     int64
+	Allocs   int64
+	Duration time.Duration
+}
+
+// NewCounters() returns empty counters
+func NewCounters() *Counters {
+	return &Counters{ops: make(map[string]OpTotals)}
+}
+
+// Record() adds s to the totals of s.Op
+func (c *Counters) Record(s OpStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := c.ops[s.Op]
+	t.Calls++
+	t.Muls += s.Muls
+	t.Mods += s.Mods
+	t.Allocs += s.Allocs
+	t.Duration += s.Duration
+	c.ops[s.Op] = t
+}
+
+// Snapshot() returns a copy of the totals keyed by operation name
+func (c *Counters) Snapshot() map[string]OpTotals {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r := make(map[string]OpTotals, len(c.ops))
+	for k, v := range c.ops {
+		r[k] = v
+	}
+	return r
+}
+
+// Reset() clears every total
+func (c *Counters) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ops = make(map[string]OpTotals)
+}
+
+// String() returns the totals as a JSON object
+func (c *Counters) String() string {
+	b, err := json.Marshal(c.Snapshot())
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
@@ -0,0 +1,110 @@
+package polynomial
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+type recordingHook struct {
+	mu    sync.Mutex
+	stats []OpStats
+}
+
+func (h *recordingHook) Record(s OpStats) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.stats = append(h.stats, s)
+}
+
+func TestMetricsHook(t *testing.T) {
+	h := &recordingHook{}
+	SetMetricsHook(h)
+	defer SetMetricsHook(nil)
+	m := big.NewInt(97)
+	p := NewPolyInts(1, 2, 3)
+	q := NewPolyInts(4, 5)
+	p.Mul(q, m)
+	p.Mul(q, nil)
+	p.Div(q, m)
+	p.Eval(big.NewInt(2), nil)
+	p.Eval(big.NewInt(2), m)
+	saved := GetTuning()
+	SetTuning(Tuning{EagerReduction: true})
+	p.Mul(q, m)
+	p.Eval(big.NewInt(2), m)
+	SetTuning(saved)
+	SetMetricsHook(nil)
+	p.Mul(q, m)
+
+	cases := []struct {
+		op         string
+		muls, mods int64
+	}{
+		// the 4 coefficients of the product are reduced once each
+		{"Mul", 6, 4},
+		{"Mul", 6, 0},
+		{"Div", 6, 6},
+		{"Eval", 6, 0},
+		{"Eval", 6, 3 + 1},
+		{"Mul", 6, 6},
+		{"Eval", 6, 6},
+	}
+	if len(h.stats) != len(cases) {
+		t.Fatalf("%d recorded calls, expected %d", len(h.stats), len(cases))
+	}
+	for i, c := range cases {
+		s := h.stats[i]
+		if s.Op != c.op || s.Muls != c.muls || s.Mods != c.mods || s.Duration < 0 || s.Allocs < 0 {
+			t.Errorf("call %d: %+v, expected %s with %d muls and %d mods", i, s, c.op, c.muls, c.mods)
+		}
+	}
+}
+
+func TestMulCostKaratsuba(t *testing.T) {
+	// with threshold 2 a product of two 4-term operands splits into three 2x2 products,
+	// each splitting again into three 1x1 products reduced along with their 3-term result
+	tn := Tuning{KaratsubaThreshold: 2}
+	c := mulCost(4, 4, nil, tn)
+	if c.muls != 9 || c.mods != 0 {
+		t.Errorf("mulCost(4, 4) = %+v, expected 9 muls", c)
+	}
+	c = mulCost(4, 4, big.NewInt(97), tn)
+	if c.muls != 9 || c.mods != 3*(3+3)+7 {
+		t.Errorf("mulCost(4, 4, 97) = %+v, expected 9 muls and 25 mods", c)
+	}
+	if c := mulCost(4, 4, nil, Tuning{}); c.muls != 16 {
+		t.Errorf("schoolbook mulCost(4, 4) = %+v, expected 16 muls", c)
+	}
+	// 4 + 4 - 1 coefficients need transforms of size 8: 3 * 12 butterflies, 8 pointwise products and 8 scalings
+	if c := mulCost(4, 4, big.NewInt(97), Tuning{NTTThreshold: 4}); c.muls != 2*36+16 || c.mods != 4*36+32 {
+		t.Errorf("NTT mulCost(4, 4, 97) = %+v, expected 88 muls and 176 mods", c)
+	}
+}
+
+func TestCounters(t *testing.T) {
+	c := NewCounters()
+	SetMetricsHook(c)
+	defer SetMetricsHook(nil)
+	p := NewPolyInts(1, 1)
+	for i := 0; i < 3; i++ {
+		p.Mul(p.Clone(0), nil)
+	}
+	SetMetricsHook(nil)
+	s := c.Snapshot()
+	if s["Mul"].Calls != 3 || s["Mul"].Muls != 12 {
+		t.Errorf("Mul totals %+v, expected 3 calls and 12 muls", s["Mul"])
+	}
+	var decoded map[string]OpTotals
+	if err := json.Unmarshal([]byte(c.String()), &decoded); err != nil {
+		t.Fatalf("String() is not JSON: %v", err)
+	}
+	if decoded["Mul"] != s["Mul"] {
+		t.Errorf("String() decoded to %+v, expected %+v", decoded["Mul"], s["Mul"])
+	}
+	c.Reset()
+	if len(c.Snapshot()) != 0 {
+		t.Errorf("Reset() left %v", c.Snapshot())
+	}
+}
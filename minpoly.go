@@ -0,0 +1,91 @@
+package polynomial
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+// randomVector() returns n uniform residues modulo m
+func randomVector(n int, m *big.Int) []*big.Int {
+	v := make([]*big.Int, n)
+	for i := range v {
+		v[i], _ = rand.Int(rand.Reader, m)
+	}
+	return v
+}
+
+// dot() returns u . v modulo m
+func dot(u, v []*big.Int, m *big.Int) *big.Int {
+	s, t := new(big.Int), new(big.Int)
+	for i := range u {
+		s.Add(s, t.Mul(u[i], v[i]))
+	}
+	return s.Mod(s, m)
+}
+
+// applyPoly() returns G(A) w by Horner's rule, where apply(v) = A v
+func applyPoly(g Poly, apply func([]*big.Int) []*big.Int, w []*big.Int, m *big.Int) []*big.Int {
+	r := make([]*big.Int, len(w))
+	for i := range r {
+		r[i] = new(big.Int)
+	}
+	for k := g.GetDegree(); k >= 0; k-- {
+		r = apply(r)
+		for i := range r {
+			r[i].Add(r[i], new(big.Int).Mul(g[k], w[i])).Mod(r[i], m)
+		}
+	}
+	return r
+}
+
+// isZeroVector() checks that every entry of v is zero
+func isZeroVector(v []*big.Int) bool {
+	for _, c := range v {
+		if c.Sign() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// minPolyOp() returns the minimal polynomial of the n x n operator v -> apply(v) modulo a prime m
+// Each round runs Berlekamp-Massey on the Krylov projections u . A^i v, i < 2n, whose minimal polynomial divides
+// that of A, and takes the lcm with the previous rounds; the lcm G is accepted once G(A) w = 0 for enough random w
+// that a wrong answer has probability below 2^-64 (a proper divisor leaves a w with G(A) w = 0 with probability
+// at most 1/m), or at once when deg G = n
+func minPolyOp(apply func([]*big.Int) []*big.Int, n int, m *big.Int) Poly {
+	g := NewPolyInts(1)
+	checks := 64/(m.BitLen()-1) + 1
+	for g.GetDegree() < n {
+		u, v := randomVector(n, m), randomVector(n, m)
+		seq := make([]*big.Int, 2*n)
+		for i := range seq {
+			seq[i] = dot(u, v, m)
+			v = apply(v)
+		}
+		f := BerlekampMassey(seq, m)
+		g = normalQuo(g.Mul(f.Clone(0), m), normalGcd(g, f, m), m)
+		ok := true
+		for i := 0; i < checks && ok; i++ {
+			ok = isZeroVector(applyPoly(g, apply, randomVector(n, m), m))
+		}
+		if ok {
+			break
+		}
+	}
+	return g
+}
+
+// MinPoly() returns the monic polynomial G of least degree with G(A) = 0 for a square matrix A modulo a prime m
+// The result is correct with probability at least 1 - 2^-64 (see minPolyOp()) and divides CharPoly(A, m)
+// It panics if A is not square or m is nil
+func MinPoly(a [][]*big.Int, m *big.Int) Poly {
+	if !isSquare(a) {
+		panic("polynomial: MinPoly needs a square matrix")
+	}
+	if m == nil {
+		panic("polynomial: MinPoly needs a prime modulus")
+	}
+	a = copyMatrix(a, m)
+	return minPolyOp(func(v []*big.Int) []*big.Int { return matVec(a, v, m) }, len(a), m)
+}
@@ -0,0 +1,39 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMinPoly(t *testing.T) {
+	cases := []struct {
+		a    [][]*big.Int
+		m    int64
+		want Poly
+	}{
+		{intMatrix(), 7, NewPolyInts(1)},
+		// the identity: x - 1
+		{intMatrix([]int64{1, 0, 0}, []int64{0, 1, 0}, []int64{0, 0, 1}), 7, NewPolyInts(6, 1)},
+		// diag(2, 2, 3): (x - 2)(x - 3), while CharPoly is (x - 2)^2 (x - 3)
+		{intMatrix([]int64{2, 0, 0}, []int64{0, 2, 0}, []int64{0, 0, 3}), 101, NewPolyInts(6, 96, 1)},
+		// a Jordan block for 2 and the scalar 2: (x - 2)^2
+		{intMatrix([]int64{2, 1, 0}, []int64{0, 2, 0}, []int64{0, 0, 2}), 2, NewPolyInts(0, 0, 1)},
+		{intMatrix([]int64{2, 1, 0}, []int64{0, 2, 0}, []int64{0, 0, 2}), 5, NewPolyInts(4, 1, 1)},
+		// the nilpotent shift of length 3
+		{intMatrix([]int64{0, 1, 0}, []int64{0, 0, 1}, []int64{0, 0, 0}), 3, NewPolyInts(0, 0, 0, 1)},
+		{intMatrix([]int64{0, 0}, []int64{0, 0}), 3, NewPolyInts(0, 1)},
+	}
+	for _, c := range cases {
+		if got := MinPoly(c.a, big.NewInt(c.m)); !got.Equal(c.want) {
+			t.Errorf("MinPoly(%v) mod %d = %v, expected %v", c.a, c.m, got, c.want)
+		}
+	}
+
+	// an LFSR state update over GF(2) is the companion matrix of its feedback polynomial
+	m := big.NewInt(2)
+	p := NewPolyInts(1, 1, 0, 0, 1, 0, 1)
+	comp, _ := p.CompanionMatrix(m)
+	if got := MinPoly(comp, m); !got.Equal(p) {
+		t.Errorf("MinPoly(companion of %v) = %v", p, got)
+	}
+}
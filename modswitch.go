@@ -0,0 +1,19 @@
+package polynomial
+
+import "math/big"
+
+// RoundedScale() switches P from modulus fromQ to modulus toQ: every coefficient is lifted to its centered
+// representative c in (-fromQ/2, fromQ/2] and replaced by round(toQ c / fromQ) mod toQ, rounding halves up
+// Centering first keeps small negative coefficients small, so the added error is at most 1/2 per coefficient
+func RoundedScale(p Poly, fromQ, toQ *big.Int) Poly {
+	c := CenterLift(p, fromQ)
+	den := new(big.Int).Lsh(fromQ, 1)
+	r := make(Poly, len(c))
+	for i, x := range c {
+		// floor((2 toQ x + fromQ) / (2 fromQ))
+		r[i] = new(big.Int).Mul(x, toQ)
+		r[i].Lsh(r[i], 1).Add(r[i], fromQ).Div(r[i], den).Mod(r[i], toQ)
+	}
+	r.trim()
+	return r
+}
@@ -0,0 +1,28 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRoundedScale(t *testing.T) {
+	cases := []struct {
+		p          Poly
+		fromQ, toQ int64
+		want       Poly
+	}{
+		// 10 * 7 / 100 = 0.7, 10 * 25 / 100 = 2.5, 10 * 99 / 100 -> -0.1
+		{NewPolyInts(7, 25, 99), 100, 10, NewPolyInts(1, 3, 0)},
+		// 60 is -40 centered: -4; 55 is -45: -4.5 rounds up to -4; 56 is -44: -4.4
+		{NewPolyInts(60, 55, 56, 50), 100, 10, NewPolyInts(6, 6, 6, 5)},
+		// -3000 * 1024 / 12289 = -249.98
+		{NewPolyInts(-3000, 12289), 12289, 1024, NewPolyInts(774)},
+		{NewPolyInts(3, 7), 8, 64, NewPolyInts(24, 56)},
+		{NewPolyInts(0), 17, 5, NewPolyInts(0)},
+	}
+	for _, c := range cases {
+		if got := RoundedScale(c.p, big.NewInt(c.fromQ), big.NewInt(c.toQ)); !got.Equal(c.want) {
+			t.Errorf("RoundedScale(%v, %d, %d) = %v, expected %v", c.p, c.fromQ, c.toQ, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package polynomial
+
+import "math/big"
+
+// fromCoeffsIn() returns sum cs[i] v^i, the inverse of CoeffsIn()
+func fromCoeffsIn(cs []MultiPoly, nvars, v int, m *big.Int) MultiPoly {
+	a := newMultiAcc(nvars)
+	e := make([]int, nvars)
+	for i, c := range cs {
+		for _, t := range c.Terms {
+			copy(e, t.Exp)
+			e[v] += i
+			a.add(e, t.Coeff)
+		}
+	}
+	return a.result(m)
+}
+
+// mainVar() returns the first variable P or Q depends on, or -1 if both are constants
+func mainVar(p, q MultiPoly) int {
+	for v := 0; v < p.NVars; v++ {
+		if p.DegreeIn(v) > 0 || q.DegreeIn(v) > 0 {
+			return v
+		}
+	}
+	return -1
+}
+
+// exactQuo() returns P / Q for a divisor Q of P; over Z the quotient is integral when Q is primitive
+func exactQuo(p, q MultiPoly, m *big.Int) MultiPoly {
+	qs, _, s := p.DivRem([]MultiPoly{q}, LexOrder, m)
+	if s.Cmp(big.NewInt(1)) == 0 {
+		return qs[0]
+	}
+	r := MultiPoly{NVars: p.NVars, Terms: make([]MonoTerm, len(qs[0].Terms))}
+	for i, t := range qs[0].Terms {
+		r.Terms[i] = MonoTerm{t.Exp, new(big.Int).Quo(t.Coeff, s)}
+	}
+	return r
+}
+
+// contentIn() returns the gcd of the coefficients of P in v and the primitive part P / content
+func (p MultiPoly) contentIn(v int, m *big.Int) (MultiPoly, MultiPoly) {
+	c := MultiPoly{NVars: p.NVars}
+	for _, ci := range p.CoeffsIn(v) {
+		c = c.Gcd(ci, m)
+	}
+	if c.IsZero() {
+		return c, p
+	}
+	return c, exactQuo(p, c, m)
+}
+
+// premIn() returns a pseudo-remainder of P by Q in the variable v: lc(Q)^k P mod Q for some k, with
+// lc the leading coefficient in v
+func premIn(p, q MultiPoly, v int, m *big.Int) MultiPoly {
+	qc := q.CoeffsIn(v)
+	dq := len(qc) - 1
+	lq := qc[dq]
+	r := p
+	for !r.IsZero() && r.DegreeIn(v) >= dq {
+		rc := r.CoeffsIn(v)
+		shift := make([]int, p.NVars)
+		shift[v] = len(rc) - 1 - dq
+		r = r.Mul(lq, m).Sub(q.Mul(rc[len(rc)-1], m).mulTerm(shift, big.NewInt(1), m), m)
+	}
+	return r
+}
+
+// Gcd() returns the greatest common divisor of P and Q, monic in lex order modulo a prime m, or over Z with the
+// integer content included and a positive leading coefficient
+// P and Q are seen as polynomials in their first variable v with coefficients in the others: the gcd of their
+// contents is computed recursively, and the primitive parts run a primitive pseudo-remainder sequence in v
+func (p MultiPoly) Gcd(q MultiPoly, m *big.Int) MultiPoly {
+	p, q = p.Add(MultiPoly{NVars: p.NVars}, m), q.Add(MultiPoly{NVars: q.NVars}, m)
+	switch {
+	case p.IsZero():
+		return q.normalizeGcd(m)
+	case q.IsZero():
+		return p.normalizeGcd(m)
+	}
+	v := mainVar(p, q)
+	if v < 0 {
+		if m != nil {
+			return constMulti(p.NVars, 1)
+		}
+		g := new(big.Int).GCD(nil, nil, new(big.Int).Abs(p.Terms[0].Coeff), new(big.Int).Abs(q.Terms[0].Coeff))
+		return NewMultiPoly(p.NVars, MonoTerm{make([]int, p.NVars), g})
+	}
+	cp, pp := p.contentIn(v, m)
+	cq, pq := q.contentIn(v, m)
+	c := cp.Gcd(cq, m)
+	if pp.DegreeIn(v) < pq.DegreeIn(v) {
+		pp, pq = pq, pp
+	}
+	var g MultiPoly
+	for {
+		if pq.IsZero() {
+			g = pp
+			break
+		}
+		if pq.DegreeIn(v) == 0 {
+			g = constMulti(p.NVars, 1)
+			break
+		}
+		r := premIn(pp, pq, v, m)
+		pp = pq
+		if !r.IsZero() {
+			_, r = r.contentIn(v, m)
+		}
+		pq = r
+	}
+	_, g = g.contentIn(v, m)
+	return c.Mul(g, m).normalizeGcd(m)
+}
+
+// normalizeGcd() makes P monic modulo m, or gives it a positive leading coefficient over Z (in lex order)
+func (p MultiPoly) normalizeGcd(m *big.Int) MultiPoly {
+	if p.IsZero() {
+		return p
+	}
+	if m != nil {
+		return p.normalize(LexOrder, m)
+	}
+	if p.Terms[0].Coeff.Sign() < 0 {
+		return p.Neg()
+	}
+	return p
+}
@@ -0,0 +1,39 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMultiPolyGcd(t *testing.T) {
+	// g = x1 x2 + 3, a = g (x1 - x2^2), b = g (2 x1 + x2 + 1)
+	g := NewMultiPoly(2, mono(1, 1, 1), mono(3, 0, 0))
+	a := g.Mul(NewMultiPoly(2, mono(1, 1, 0), mono(-1, 0, 2)), nil)
+	b := g.Mul(NewMultiPoly(2, mono(2, 1, 0), mono(1, 0, 1), mono(1, 0, 0)), nil)
+	x := NewMultiPoly(3, mono(1, 1, 0, 0))
+	y := NewMultiPoly(3, mono(1, 0, 1, 0))
+	z := NewMultiPoly(3, mono(1, 0, 0, 1))
+	cases := []struct {
+		p, q MultiPoly
+		m    *big.Int
+		want MultiPoly
+	}{
+		{a, b, nil, g},
+		{a.Neg(), b, nil, g},
+		{a, MultiPoly{NVars: 2}, nil, a},
+		// 6 x1 and 4 x1^2: 2 x1
+		{NewMultiPoly(2, mono(6, 1, 0)), NewMultiPoly(2, mono(4, 2, 0)), nil, NewMultiPoly(2, mono(2, 1, 0))},
+		{NewMultiPoly(2, mono(6, 0, 0)), NewMultiPoly(2, mono(4, 0, 0)), nil, NewMultiPoly(2, mono(2, 0, 0))},
+		// x1 x2 + 3 is made monic modulo 7 already; a content in x2 only
+		{a, b, big.NewInt(7), g},
+		{a.Mul(NewMultiPoly(2, mono(1, 0, 1)), nil), b.Mul(NewMultiPoly(2, mono(1, 0, 2)), nil), nil, g.Mul(NewMultiPoly(2, mono(1, 0, 1)), nil)},
+		// (x + y)(y + z)^2 and (x + y)^2 (x + z): x + y
+		{x.Add(y, nil).Mul(y.Add(z, nil), nil).Mul(y.Add(z, nil), nil), x.Add(y, nil).Mul(x.Add(y, nil), nil).Mul(x.Add(z, nil), nil), nil, x.Add(y, nil)},
+		{x.Mul(y, nil), z, nil, constMulti(3, 1)},
+	}
+	for _, c := range cases {
+		if got := c.p.Gcd(c.q, c.m); !got.Equal(c.want) {
+			t.Errorf("Gcd(%v, %v) mod %v = %v, expected %v", c.p, c.q, c.m, got, c.want)
+		}
+	}
+}
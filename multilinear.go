@@ -0,0 +1,98 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Multilinear is the multilinear extension of a vector over {0,1}^n modulo m
+// Evals[i] is the value at the point whose bits (most significant first) are the bits of i,
+// so the first variable selects between the lower and the upper half of Evals
+type Multilinear struct {
+	Evals []*big.Int
+	m     *big.Int
+}
+
+var (
+	errMLELength = errors.New("polynomial: the number of evaluations must be a power of two")
+	errMLEPoint  = errors.New("polynomial: the point does not match the number of variables")
+	errMLEFixed  = errors.New("polynomial: every variable is already fixed")
+)
+
+// NewMultilinear() builds the multilinear extension of evals (its length must be 2^n)
+func NewMultilinear(evals []*big.Int, m *big.Int) (*Multilinear, error) {
+	n := len(evals)
+	if n == 0 || n&(n-1) != 0 {
+		return nil, errMLELength
+	}
+	f := &Multilinear{Evals: make([]*big.Int, n), m: m}
+	for i, e := range evals {
+		f.Evals[i] = new(big.Int).Mod(e, m)
+	}
+	return f, nil
+}
+
+// NumVars() returns n
+func (f *Multilinear) NumVars() int {
+	n := 0
+	for 1<<uint(n) < len(f.Evals) {
+		n++
+	}
+	return n
+}
+
+// FixFirst() substitutes r for the first variable and returns a polynomial with one variable less
+// f'(x_2, ..., x_n) = (1 - r) * f(0, x_2, ...) + r * f(1, x_2, ...)
+func (f *Multilinear) FixFirst(r *big.Int) (*Multilinear, error) {
+	half := len(f.Evals) / 2
+	if half == 0 {
+		return nil, errMLEFixed
+	}
+	g := &Multilinear{Evals: make([]*big.Int, half), m: f.m}
+	for i := 0; i < half; i++ {
+		v := new(big.Int).Sub(f.Evals[i+half], f.Evals[i])
+		v.Mul(v, r)
+		v.Add(v, f.Evals[i])
+		g.Evals[i] = v.Mod(v, f.m)
+	}
+	return g, nil
+}
+
+// Eval() returns f(point) for a point of NumVars() coordinates
+func (f *Multilinear) Eval(point []*big.Int) (*big.Int, error) {
+	if len(point) != f.NumVars() {
+		return nil, errMLEPoint
+	}
+	g := f
+	for _, r := range point {
+		g, _ = g.FixFirst(r)
+	}
+	return new(big.Int).Set(g.Evals[0]), nil
+}
+
+// Sum() returns the sum of f over the whole hypercube
+func (f *Multilinear) Sum() *big.Int {
+	s := big.NewInt(0)
+	for _, e := range f.Evals {
+		s.Add(s, e)
+	}
+	return s.Mod(s, f.m)
+}
+
+// RoundPoly() returns the sumcheck message g(X) = sum of f(X, b_2, ..., b_n) over {0,1}^(n-1)
+// g has degree at most one and g(0) + g(1) == Sum()
+func (f *Multilinear) RoundPoly() Poly {
+	half := len(f.Evals) / 2
+	if half == 0 {
+		return Poly{new(big.Int).Set(f.Evals[0])}
+	}
+	g0, g1 := big.NewInt(0), big.NewInt(0)
+	for i := 0; i < half; i++ {
+		g0.Add(g0, f.Evals[i])
+		g1.Add(g1, f.Evals[i+half])
+	}
+	g1.Sub(g1, g0)
+	g := Poly{g0.Mod(g0, f.m), g1.Mod(g1, f.m)}
+	g.trim()
+	return g
+}
@@ -0,0 +1,61 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMultilinear(t *testing.T) {
+	m := big.NewInt(311)
+	f, err := NewMultilinear(bigs(1, 2, 3, 4, 5, 6, 7, 8), m)
+	if err != nil {
+		t.Fatalf("NewMultilinear() failed: %v", err)
+	}
+	if f.NumVars() != 3 {
+		t.Errorf("NumVars() = %v, want 3", f.NumVars())
+	}
+	// f agrees with the vector on the hypercube
+	for i := 0; i < 8; i++ {
+		pt := bigs(int64(i>>2&1), int64(i>>1&1), int64(i&1))
+		v, _ := f.Eval(pt)
+		if v.Int64() != int64(i+1) {
+			t.Errorf("f(%v) = %v, want %v", pt, v, i+1)
+		}
+	}
+	// f(x1, x2, x3) = 1 + 4*x1 + 2*x2 + x3
+	v, _ := f.Eval(bigs(10, 20, 30))
+	if v.Int64() != 1+40+40+30 {
+		t.Errorf("f(10, 20, 30) = %v, want %v", v, 1+40+40+30)
+	}
+	if _, err := f.Eval(bigs(1, 2)); err == nil {
+		t.Errorf("Eval() with a wrong number of coordinates should fail")
+	}
+	if _, err := NewMultilinear(bigs(1, 2, 3), m); err == nil {
+		t.Errorf("NewMultilinear() should reject a non power of two length")
+	}
+}
+
+func TestSumcheckRounds(t *testing.T) {
+	m := big.NewInt(311)
+	f, _ := NewMultilinear(bigs(43, 53, 45, 63, 43, 55, 75, 11), m)
+	claim := f.Sum()
+	point := bigs(17, 200, 5)
+	g := f
+	for _, r := range point {
+		rp := g.RoundPoly()
+		s := new(big.Int).Add(rp.Eval(big.NewInt(0), m), rp.Eval(big.NewInt(1), m))
+		s.Mod(s, m)
+		if s.Cmp(claim) != 0 {
+			t.Fatalf("g(0) + g(1) = %v does not match the claim %v", s, claim)
+		}
+		claim = rp.Eval(r, m)
+		g, _ = g.FixFirst(r)
+	}
+	v, _ := f.Eval(point)
+	if v.Cmp(claim) != 0 || g.Evals[0].Cmp(claim) != 0 {
+		t.Errorf("the final claim %v does not match f(%v) = %v", claim, point, v)
+	}
+	if _, err := g.FixFirst(big.NewInt(1)); err == nil {
+		t.Errorf("FixFirst() without any free variable should fail")
+	}
+}
@@ -0,0 +1,70 @@
+package polynomial
+
+import "math/big"
+
+// normalForm() makes P monic modulo m, or primitive with a positive leading coefficient over Z
+func (p Poly) normalForm(m *big.Int) Poly {
+	if m != nil {
+		q, err := p.Monic(m)
+		if err != nil {
+			return NewPolyInts(0)
+		}
+		return q
+	}
+	q := p.primitivePart()
+	if q[q.GetDegree()].Sign() < 0 {
+		q = q.Neg()
+	}
+	return q
+}
+
+// normalGcd() returns gcd(P, Q) in normal form
+func normalGcd(p, q Poly, m *big.Int) Poly {
+	if m != nil {
+		return p.Clone(0).Gcd(q.Clone(0), m).normalForm(m)
+	}
+	g, _ := NewRatPoly(p).Gcd(NewRatPoly(q)).ClearDenominators()
+	return g.normalForm(nil)
+}
+
+// normalQuo() returns P / Q in normal form for an exact division (over Z, up to a constant)
+func normalQuo(p, q Poly, m *big.Int) Poly {
+	if m != nil {
+		r, _ := p.Clone(0).Div(q.Clone(0), m)
+		return r.normalForm(m)
+	}
+	r, _ := NewRatPoly(p).Div(NewRatPoly(q))
+	s, _ := r.ClearDenominators()
+	return s.normalForm(nil)
+}
+
+// MultiplicityProfile() returns F_1, F_2, ..., F_k where the roots of F_i are exactly the roots of P of multiplicity i,
+// so P = c F_1 F_2^2 ... F_k^k; every F_i is monic modulo m, or primitive with a positive leading coefficient over Z
+// G_0 = P, G_i = gcd(G_(i-1), G_(i-1)') holds the roots of multiplicity > i, H_i = G_(i-1) / G_i those of
+// multiplicity >= i, and F_i = H_i / H_(i+1)
+// Modulo a prime m the result is exact when every multiplicity is below m
+func (p Poly) MultiplicityProfile(m *big.Int) []Poly {
+	g := p.Clone(0)
+	g.sanitize(m)
+	g.trim()
+	var hs []Poly
+	for g.GetDegree() > 0 {
+		d := g.Derivative(m)
+		if d.isZero() {
+			// G is a polynomial in x^m, whose multiplicities are all multiples of m
+			break
+		}
+		next := normalGcd(g, d, m)
+		hs = append(hs, normalQuo(g, next, m))
+		g = next
+	}
+	fs := make([]Poly, len(hs))
+	for i := range hs {
+		if i+1 < len(hs) {
+			fs[i] = normalQuo(hs[i], hs[i+1], m)
+		} else {
+			fs[i] = hs[i]
+		}
+	}
+	return fs
+}
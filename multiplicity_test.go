@@ -0,0 +1,45 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMultiplicityProfile(t *testing.T) {
+	pow := func(p Poly, k int) Poly {
+		r := NewPolyInts(1)
+		for i := 0; i < k; i++ {
+			r = r.Mul(p.Clone(0), nil)
+		}
+		return r
+	}
+	m := big.NewInt(101)
+	cases := []struct {
+		p    Poly
+		m    *big.Int
+		want []Poly
+	}{
+		{Wilkinson(3), nil, []Poly{Wilkinson(3)}},
+		// 3 (x - 1)(x + 2)^3 (x^2 + 1)^3
+		{NewPolyInts(-3, 3).Mul(pow(NewPolyInts(2, 1), 3), nil).Mul(pow(NewPolyInts(1, 0, 1), 3), nil), nil,
+			[]Poly{NewPolyInts(-1, 1), NewPolyInts(1), NewPolyInts(2, 1, 2, 1)}},
+		// (2x - 1)^2 x has a non-monic factor over Z
+		{pow(NewPolyInts(-1, 2), 2).Mul(NewPolyInts(0, 1), nil), nil, []Poly{NewPolyInts(0, 1), NewPolyInts(-1, 2)}},
+		{pow(NewPolyInts(-1, 2), 2).Mul(NewPolyInts(0, 1), nil), m, []Poly{NewPolyInts(0, 1), NewPolyInts(50, 1)}},
+		{pow(NewPolyInts(-5, 1), 4).Mul(NewPolyInts(-7, 1), nil), m, []Poly{NewPolyInts(94, 1), NewPolyInts(1), NewPolyInts(1), NewPolyInts(96, 1)}},
+		{NewPolyInts(7), nil, []Poly{}},
+	}
+	for _, c := range cases {
+		got := c.p.MultiplicityProfile(c.m)
+		if len(got) != len(c.want) {
+			t.Errorf("MultiplicityProfile(%v) mod %v = %v, want %v", c.p, c.m, got, c.want)
+			continue
+		}
+		for i := range got {
+			if got[i].Compare(&c.want[i]) != 0 {
+				t.Errorf("MultiplicityProfile(%v) mod %v = %v, want %v", c.p, c.m, got, c.want)
+				break
+			}
+		}
+	}
+}
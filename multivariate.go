@@ -0,0 +1,282 @@
+package polynomial
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// MonoTerm is Coeff * x1^Exp[0] * x2^Exp[1] * ...
+type MonoTerm struct {
+	Exp   []int
+	Coeff *big.Int
+}
+
+// Data structure for a multivariate polynomial in NVars variables
+// Terms only holds non-zero coefficients and is sorted in decreasing lexicographic order
+// of the exponent vectors, so two equal polynomials have identical Terms
+// f(x1, x2) = 3x1^2x2 + 5 => [{[2 1] 3} {[0 0] 5}]
+type MultiPoly struct {
+	NVars int
+	Terms []MonoTerm
+}
+
+// expKey() turns an exponent vector into a map key
+func expKey(e []int) string {
+	return fmt.Sprint(e)
+}
+
+// lexCmp() compares exponent vectors lexicographically
+func lexCmp(a, b []int) int {
+	for i := range a {
+		switch {
+		case a[i] > b[i]:
+			return 1
+		case a[i] < b[i]:
+			return -1
+		}
+	}
+	return 0
+}
+
+// multiAcc accumulates terms by exponent vector
+type multiAcc struct {
+	nvars int
+	exps  map[string][]int
+	coefs map[string]*big.Int
+}
+
+func newMultiAcc(nvars int) *multiAcc {
+	return &multiAcc{nvars, make(map[string][]int), make(map[string]*big.Int)}
+}
+
+func (a *multiAcc) add(e []int, c *big.Int) {
+	k := expKey(e)
+	if v, ok := a.coefs[k]; ok {
+		v.Add(v, c)
+		return
+	}
+	a.exps[k] = append([]int(nil), e...)
+	a.coefs[k] = new(big.Int).Set(c)
+}
+
+// result() reduces modulo m (if not nil), drops zeros and sorts the terms
+func (a *multiAcc) result(m *big.Int) MultiPoly {
+	p := MultiPoly{NVars: a.nvars}
+	for k, c := range a.coefs {
+		if m != nil {
+			c.Mod(c, m)
+		}
+		if c.Sign() != 0 {
+			p.Terms = append(p.Terms, MonoTerm{a.exps[k], c})
+		}
+	}
+	sort.Slice(p.Terms, func(i, j int) bool { return lexCmp(p.Terms[i].Exp, p.Terms[j].Exp) > 0 })
+	return p
+}
+
+// NewMultiPoly() builds a polynomial in nvars variables from (possibly repeated) terms
+// Every exponent vector must have nvars entries
+func NewMultiPoly(nvars int, terms ...MonoTerm) MultiPoly {
+	a := newMultiAcc(nvars)
+	for _, t := range terms {
+		a.add(t.Exp, t.Coeff)
+	}
+	return a.result(nil)
+}
+
+// FromUnivariate() lifts P(x) to a polynomial in nvars variables where x is variable v
+func FromUnivariate(p Poly, nvars, v int) MultiPoly {
+	a := newMultiAcc(nvars)
+	for i, c := range p {
+		e := make([]int, nvars)
+		e[v] = i
+		a.add(e, c)
+	}
+	return a.result(nil)
+}
+
+// IsZero() checks if P = 0
+func (p MultiPoly) IsZero() bool {
+	return len(p.Terms) == 0
+}
+
+// Degree() returns the total degree (0 for the zero polynomial)
+func (p MultiPoly) Degree() int {
+	d := 0
+	for _, t := range p.Terms {
+		s := 0
+		for _, e := range t.Exp {
+			s += e
+		}
+		if s > d {
+			d = s
+		}
+	}
+	return d
+}
+
+// DegreeIn() returns the degree in the variable v
+func (p MultiPoly) DegreeIn(v int) int {
+	d := 0
+	for _, t := range p.Terms {
+		if t.Exp[v] > d {
+			d = t.Exp[v]
+		}
+	}
+	return d
+}
+
+// Equal() checks if P == Q
+func (p MultiPoly) Equal(q MultiPoly) bool {
+	if p.NVars != q.NVars || len(p.Terms) != len(q.Terms) {
+		return false
+	}
+	for i := range p.Terms {
+		if lexCmp(p.Terms[i].Exp, q.Terms[i].Exp) != 0 || p.Terms[i].Coeff.Cmp(q.Terms[i].Coeff) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Add() adds two polynomials
+// modulo m can be nil
+func (p MultiPoly) Add(q MultiPoly, m *big.Int) MultiPoly {
+	a := newMultiAcc(p.NVars)
+	for _, t := range p.Terms {
+		a.add(t.Exp, t.Coeff)
+	}
+	for _, t := range q.Terms {
+		a.add(t.Exp, t.Coeff)
+	}
+	return a.result(m)
+}
+
+// Neg() returns -P
+func (p MultiPoly) Neg() MultiPoly {
+	q := MultiPoly{NVars: p.NVars, Terms: make([]MonoTerm, len(p.Terms))}
+	for i, t := range p.Terms {
+		q.Terms[i] = MonoTerm{append([]int(nil), t.Exp...), new(big.Int).Neg(t.Coeff)}
+	}
+	return q
+}
+
+// Sub() subtracts Q from P
+func (p MultiPoly) Sub(q MultiPoly, m *big.Int) MultiPoly {
+	return p.Add(q.Neg(), m)
+}
+
+// Mul() multiplies two polynomials
+func (p MultiPoly) Mul(q MultiPoly, m *big.Int) MultiPoly {
+	a := newMultiAcc(p.NVars)
+	e := make([]int, p.NVars)
+	c := new(big.Int)
+	for _, s := range p.Terms {
+		for _, t := range q.Terms {
+			for i := range e {
+				e[i] = s.Exp[i] + t.Exp[i]
+			}
+			c.Mul(s.Coeff, t.Coeff)
+			a.add(e, c)
+		}
+	}
+	return a.result(m)
+}
+
+// Eval() returns P(point) where point has NVars coordinates
+func (p MultiPoly) Eval(point []*big.Int, m *big.Int) *big.Int {
+	y := big.NewInt(0)
+	for _, t := range p.Terms {
+		v := new(big.Int).Set(t.Coeff)
+		for i, e := range t.Exp {
+			if e > 0 {
+				v.Mul(v, new(big.Int).Exp(point[i], big.NewInt(int64(e)), m))
+			}
+		}
+		y.Add(y, v)
+		if m != nil {
+			y.Mod(y, m)
+		}
+	}
+	return y
+}
+
+// Substitute() fixes the variable v to val
+// The result still has NVars variables but does not depend on v any more
+func (p MultiPoly) Substitute(v int, val, m *big.Int) MultiPoly {
+	a := newMultiAcc(p.NVars)
+	e := make([]int, p.NVars)
+	for _, t := range p.Terms {
+		copy(e, t.Exp)
+		e[v] = 0
+		c := new(big.Int).Exp(val, big.NewInt(int64(t.Exp[v])), m)
+		c.Mul(c, t.Coeff)
+		a.add(e, c)
+	}
+	return a.result(m)
+}
+
+// ToUnivariate() substitutes point[i] for every variable i except v
+// and returns the remaining polynomial in the variable v (point[v] is ignored)
+func (p MultiPoly) ToUnivariate(v int, point []*big.Int, m *big.Int) Poly {
+	u := make(Poly, p.DegreeIn(v)+1)
+	for i := range u {
+		u[i] = big.NewInt(0)
+	}
+	for _, t := range p.Terms {
+		c := new(big.Int).Set(t.Coeff)
+		for i, e := range t.Exp {
+			if i != v && e > 0 {
+				c.Mul(c, new(big.Int).Exp(point[i], big.NewInt(int64(e)), m))
+			}
+		}
+		u[t.Exp[v]].Add(u[t.Exp[v]], c)
+	}
+	if m != nil {
+		u.sanitize(m)
+	}
+	u.trim()
+	return u
+}
+
+// pretty print, variables are named x1, x2, ...
+func (p MultiPoly) String() string {
+	if p.IsZero() {
+		return "[0]"
+	}
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i, t := range p.Terms {
+		abs := new(big.Int).Abs(t.Coeff)
+		switch {
+		case i == 0 && t.Coeff.Sign() < 0:
+			sb.WriteString("-")
+		case i > 0 && t.Coeff.Sign() < 0:
+			sb.WriteString(" - ")
+		case i > 0:
+			sb.WriteString(" + ")
+		}
+		constant := true
+		for _, e := range t.Exp {
+			if e != 0 {
+				constant = false
+			}
+		}
+		if constant || abs.Cmp(big.NewInt(1)) != 0 {
+			sb.WriteString(abs.String())
+		}
+		for v, e := range t.Exp {
+			if e == 0 {
+				continue
+			}
+			fmt.Fprintf(&sb, "x%d", v+1)
+			if e > 1 {
+				fmt.Fprintf(&sb, "^%d", e)
+			}
+		}
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
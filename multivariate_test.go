@@ -0,0 +1,74 @@
+package polynomial
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+// mono() builds a single term for tests: mono(3, 2, 1) = 3x1^2x2
+func mono(c int64, exp ...int) MonoTerm {
+	return MonoTerm{exp, big.NewInt(c)}
+}
+
+func TestMultiPolyPrint(t *testing.T) {
+	cases := []struct {
+		p   MultiPoly
+		ans string
+	}{
+		{NewMultiPoly(2), "[0]"},
+		{NewMultiPoly(2, mono(5, 0, 0), mono(3, 2, 1)), "[3x1^2x2 + 5]"},
+		{NewMultiPoly(3, mono(-1, 0, 1, 0), mono(1, 1, 0, 2), mono(-2, 0, 0, 0)), "[x1x3^2 - x2 - 2]"},
+		{NewMultiPoly(2, mono(2, 1, 0), mono(-2, 1, 0)), "[0]"},
+	}
+	for _, c := range cases {
+		if s := fmt.Sprintf("%v", c.p); s != c.ans {
+			t.Errorf("Stringify %v should be %v", s, c.ans)
+		}
+	}
+}
+
+func TestMultiPolyArithmetic(t *testing.T) {
+	// (x1 + x2)(x1 - x2) = x1^2 - x2^2
+	a := NewMultiPoly(2, mono(1, 1, 0), mono(1, 0, 1))
+	b := NewMultiPoly(2, mono(1, 1, 0), mono(-1, 0, 1))
+	prod := a.Mul(b, nil)
+	ans := NewMultiPoly(2, mono(1, 2, 0), mono(-1, 0, 2))
+	if !prod.Equal(ans) {
+		t.Errorf("%v * %v != %v (your answer was %v)", a, b, ans, prod)
+	}
+	sum := a.Add(b, nil)
+	if ans := NewMultiPoly(2, mono(2, 1, 0)); !sum.Equal(ans) {
+		t.Errorf("%v + %v != %v (your answer was %v)", a, b, ans, sum)
+	}
+	if d := a.Sub(a, nil); !d.IsZero() {
+		t.Errorf("%v - %v should be zero (got %v)", a, a, d)
+	}
+	m := big.NewInt(7)
+	sq := a.Mul(a, m) // x1^2 + 2x1x2 + x2^2
+	sq = sq.Mul(a, m) // x1^3 + 3x1^2x2 + 3x1x2^2 + x2^3
+	if sq.Degree() != 3 || sq.DegreeIn(0) != 3 || len(sq.Terms) != 4 {
+		t.Errorf("(x1 + x2)^3 = %v", sq)
+	}
+}
+
+func TestMultiPolyEval(t *testing.T) {
+	m := big.NewInt(101)
+	p := NewMultiPoly(3, mono(3, 2, 1, 0), mono(-1, 0, 0, 3), mono(7, 0, 0, 0)) // 3x1^2x2 - x3^3 + 7
+	pt := []*big.Int{big.NewInt(2), big.NewInt(5), big.NewInt(3)}
+	if v := p.Eval(pt, nil); v.Int64() != 60-27+7 {
+		t.Errorf("%v at %v = %v, want %v", p, pt, v, 60-27+7)
+	}
+	s := p.Substitute(0, big.NewInt(2), m) // 12x2 - x3^3 + 7
+	if ans := NewMultiPoly(3, mono(12, 0, 1, 0), mono(100, 0, 0, 3), mono(7, 0, 0, 0)); !s.Equal(ans) {
+		t.Errorf("%v with x1 = 2 is %v, want %v", p, s, ans)
+	}
+	u := p.ToUnivariate(2, pt, nil) // -x^3 + 67
+	if ans := NewPolyInts(67, 0, 0, -1); u.Compare(&ans) != 0 {
+		t.Errorf("%v in x3 is %v, want %v", p, u, ans)
+	}
+	l := FromUnivariate(NewPolyInts(1, 2, 3), 2, 1)
+	if ans := NewMultiPoly(2, mono(3, 0, 2), mono(2, 0, 1), mono(1, 0, 0)); !l.Equal(ans) {
+		t.Errorf("FromUnivariate() = %v, want %v", l, ans)
+	}
+}
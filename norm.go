@@ -0,0 +1,55 @@
+package polynomial
+
+import "math/big"
+
+// NormL1() returns the sum of |a_i|
+func (p Poly) NormL1() *big.Int {
+	s := new(big.Int)
+	for _, c := range p {
+		s.Add(s, new(big.Int).Abs(c))
+	}
+	return s
+}
+
+// NormL2Squared() returns the sum of a_i^2, the square of the Euclidean norm, which stays an integer
+func (p Poly) NormL2Squared() *big.Int {
+	s := new(big.Int)
+	for _, c := range p {
+		s.Add(s, new(big.Int).Mul(c, c))
+	}
+	return s
+}
+
+// NormInf() returns the largest |a_i|
+func (p Poly) NormInf() *big.Int {
+	s := new(big.Int)
+	for _, c := range p {
+		if a := new(big.Int).Abs(c); a.Cmp(s) > 0 {
+			s = a
+		}
+	}
+	return s
+}
+
+// Height() returns the (naive) height of P, the largest |a_i|
+// It is the same number as NormInf(), under the name used in Mignotte-style factor bounds
+func (p Poly) Height() *big.Int {
+	return p.NormInf()
+}
+
+// CenteredNormInf() returns the largest |a_i| after lifting every coefficient to (-m/2, m/2]
+// This is the size of the noise in lattice-based schemes, where m is the ciphertext modulus
+func (p Poly) CenteredNormInf(m *big.Int) *big.Int {
+	half := new(big.Int).Rsh(m, 1)
+	s := new(big.Int)
+	for _, c := range p {
+		a := new(big.Int).Mod(c, m)
+		if a.Cmp(half) > 0 {
+			a.Sub(m, a)
+		}
+		if a.Cmp(s) > 0 {
+			s = a
+		}
+	}
+	return s
+}
@@ -0,0 +1,51 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNorms(t *testing.T) {
+	cases := []struct {
+		p            Poly
+		l1, l2sq, li int64
+	}{
+		{NewPolyInts(3, -4), 7, 25, 4},
+		{NewPolyInts(0), 0, 0, 0},
+		{Wilkinson(3), 24, 194, 11},
+		{NewPolyInts(-5, 0, 0, 5), 10, 50, 5},
+	}
+	for _, c := range cases {
+		if got := c.p.NormL1(); got.Int64() != c.l1 {
+			t.Errorf("NormL1(%v) = %v, want %d", c.p, got, c.l1)
+		}
+		if got := c.p.NormL2Squared(); got.Int64() != c.l2sq {
+			t.Errorf("NormL2Squared(%v) = %v, want %d", c.p, got, c.l2sq)
+		}
+		if got := c.p.NormInf(); got.Int64() != c.li {
+			t.Errorf("NormInf(%v) = %v, want %d", c.p, got, c.li)
+		}
+		if got := c.p.Height(); got.Int64() != c.li {
+			t.Errorf("Height(%v) = %v, want %d", c.p, got, c.li)
+		}
+	}
+}
+
+func TestCenteredNormInf(t *testing.T) {
+	m := big.NewInt(17)
+	cases := []struct {
+		p    Poly
+		want int64
+	}{
+		{NewPolyInts(1, 16, 2), 2},
+		{NewPolyInts(9), 8},
+		{NewPolyInts(8), 8},
+		{NewPolyInts(-3, 20), 3},
+		{NewPolyInts(0), 0},
+	}
+	for _, c := range cases {
+		if got := c.p.CenteredNormInf(m); got.Int64() != c.want {
+			t.Errorf("CenteredNormInf(%v) mod %v = %v, want %d", c.p, m, got, c.want)
+		}
+	}
+}
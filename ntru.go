@@ -0,0 +1,92 @@
+package polynomial
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+// cyclicModulus() returns x^n - 1
+func cyclicModulus(n int) Poly {
+	return NewPolyInts(-1).Add(NewPolyInts(1).Clone(n), nil)
+}
+
+// CyclicMul() returns the convolution A * B in Z[x]/(x^n - 1), the product NTRU works in
+// modulo m can be nil
+func CyclicMul(a, b Poly, n int, m *big.Int) Poly {
+	r := make(Poly, n)
+	for i := range r {
+		r[i] = new(big.Int)
+	}
+	a, b = ReduceCyclic(a, n, m), ReduceCyclic(b, n, m)
+	t := new(big.Int)
+	for i, x := range a {
+		if x.Sign() == 0 {
+			continue
+		}
+		for j, y := range b {
+			k := (i + j) % n
+			r[k].Add(r[k], t.Mul(x, y))
+		}
+	}
+	r.sanitize(m)
+	r.trim()
+	return r
+}
+
+// InverseCyclic() returns the inverse of F in Z_(p^k)[x]/(x^n - 1) for a prime p, such as 3 or 2^k for NTRU
+// The inverse modulo p comes from the extended Euclidean algorithm and is lifted by Newton iteration
+// G <- G (2 - F G), which doubles the power of p each step
+func InverseCyclic(f Poly, n int, p *big.Int, k int) (Poly, error) {
+	g, err := invMod(ReduceCyclic(f, n, p), cyclicModulus(n), p)
+	if err != nil {
+		return nil, err
+	}
+	q := new(big.Int).Exp(p, big.NewInt(int64(k)), nil)
+	for e := new(big.Int).Set(p); e.Cmp(q) < 0; {
+		e.Mul(e, e)
+		two := NewPolyInts(2)
+		g = CyclicMul(g, two.Sub(CyclicMul(f, g, n, e), e), n, e)
+	}
+	return ReduceCyclic(g, n, q), nil
+}
+
+// CenterLift() maps every coefficient of P modulo m into (-m/2, m/2]
+func CenterLift(p Poly, m *big.Int) Poly {
+	half := new(big.Int).Rsh(m, 1)
+	r := make(Poly, len(p))
+	for i, c := range p {
+		r[i] = new(big.Int).Mod(c, m)
+		if r[i].Cmp(half) > 0 {
+			r[i].Sub(r[i], m)
+		}
+	}
+	r.trim()
+	return r
+}
+
+// SampleTernary() returns a random polynomial of n coefficients with exactly d1 coefficients +1, d2 coefficients
+// -1 and the others 0, from a crypto/rand shuffle
+// Panics if d1 + d2 > n or if either count is negative
+func SampleTernary(n, d1, d2 int) Poly {
+	if d1 < 0 || d2 < 0 || d1+d2 > n {
+		panic("polynomial: the ternary weights do not fit in n coefficients")
+	}
+	p := make(Poly, n+1)
+	for i := 0; i < n; i++ {
+		switch {
+		case i < d1:
+			p[i] = big.NewInt(1)
+		case i < d1+d2:
+			p[i] = big.NewInt(-1)
+		default:
+			p[i] = big.NewInt(0)
+		}
+	}
+	p[n] = big.NewInt(0)
+	for i := n - 1; i > 0; i-- {
+		j, _ := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		p[i], p[j.Int64()] = p[j.Int64()], p[i]
+	}
+	p.trim()
+	return p
+}
@@ -0,0 +1,100 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCyclicMul(t *testing.T) {
+	cases := []struct {
+		a, b Poly
+		n    int
+		m    *big.Int
+		want Poly
+	}{
+		{NewPolyInts(1, 2), NewPolyInts(3, 4), 5, nil, NewPolyInts(3, 10, 8)},
+		{NewPolyInts(0, 0, 1), NewPolyInts(0, 1), 3, nil, NewPolyInts(1)},
+		{NewPolyInts(1, 1, 1), NewPolyInts(1, 1, 1), 3, big.NewInt(5), NewPolyInts(3, 3, 3)},
+		{NewPolyInts(-1, 0, 2, 1), NewPolyInts(2, -1, 0, 3), 4, nil, NewPolyInts(-3, 7, 7, -3)},
+	}
+	for _, c := range cases {
+		if got := CyclicMul(c.a, c.b, c.n, c.m); !got.Equal(c.want) {
+			t.Errorf("CyclicMul(%v, %v, %d) mod %v = %v, expected %v", c.a, c.b, c.n, c.m, got, c.want)
+		}
+	}
+}
+
+func TestInverseCyclic(t *testing.T) {
+	// the textbook NTRU example with N = 11, p = 3 and q = 32
+	f := NewPolyInts(-1, 1, 1, 0, -1, 0, 1, 0, 0, 1, -1)
+	cases := []struct {
+		p    int64
+		k    int
+		want Poly
+	}{
+		{3, 1, NewPolyInts(1, 2, 0, 2, 2, 1, 0, 2, 1, 2)},
+		{2, 5, NewPolyInts(5, 9, 6, 16, 4, 15, 16, 22, 20, 18, 30)},
+		{3, 4, nil},
+		{2, 1, nil},
+	}
+	for _, c := range cases {
+		p := big.NewInt(c.p)
+		q := new(big.Int).Exp(p, big.NewInt(int64(c.k)), nil)
+		g, err := InverseCyclic(f, 11, p, c.k)
+		if err != nil {
+			t.Errorf("InverseCyclic() mod %v: %v", q, err)
+			continue
+		}
+		if c.want != nil && !g.Equal(c.want) {
+			t.Errorf("InverseCyclic() mod %v = %v, expected %v", q, g, c.want)
+		}
+		if got := CyclicMul(f, g, 11, q); !got.Equal(NewPolyInts(1)) {
+			t.Errorf("F * InverseCyclic() mod %v = %v", q, got)
+		}
+	}
+	// x - 1 divides x^n - 1
+	if _, err := InverseCyclic(NewPolyInts(-1, 1), 11, big.NewInt(3), 1); err != errNotInvertible {
+		t.Errorf("InverseCyclic(x - 1) error = %v", err)
+	}
+}
+
+func TestCenterLift(t *testing.T) {
+	if got := CenterLift(NewPolyInts(0, 1, 15, 16, 17, 31, -1), big.NewInt(32)); !got.Equal(NewPolyInts(0, 1, 15, 16, -15, -1, -1)) {
+		t.Errorf("CenterLift() = %v", got)
+	}
+}
+
+func TestSampleTernary(t *testing.T) {
+	for _, c := range []struct{ n, d1, d2 int }{{11, 4, 3}, {16, 0, 0}, {8, 8, 0}, {509, 127, 126}} {
+		p := SampleTernary(c.n, c.d1, c.d2)
+		plus, minus := 0, 0
+		for _, x := range p {
+			switch x.Int64() {
+			case 1:
+				plus++
+			case -1:
+				minus++
+			}
+		}
+		if len(p) > c.n || plus != c.d1 || minus != c.d2 {
+			t.Errorf("SampleTernary(%d, %d, %d) = %v", c.n, c.d1, c.d2, p)
+		}
+	}
+}
+
+func TestNTRURoundTrip(t *testing.T) {
+	const n = 11
+	p, q := big.NewInt(3), big.NewInt(32)
+	f := NewPolyInts(-1, 1, 1, 0, -1, 0, 1, 0, 0, 1, -1)
+	g := NewPolyInts(-1, 0, 1, 1, 0, 1, 0, 0, -1, 0, -1)
+	fp, _ := InverseCyclic(f, n, p, 1)
+	fq, _ := InverseCyclic(f, n, big.NewInt(2), 5)
+	h := CyclicMul(Poly{p}, CyclicMul(fq, g, n, q), n, q)
+	msg := NewPolyInts(-1, 0, 0, 1, -1, 0, 0, 0, -1, 1, 1)
+	r := NewPolyInts(-1, 0, 1, 1, 1, -1, 0, -1)
+	e := CyclicMul(r, h, n, q).Add(msg, q)
+	a := CenterLift(CyclicMul(f, e, n, q), q)
+	if got := CenterLift(CyclicMul(fp, a, n, p), p); !got.Equal(msg) {
+		t.Errorf("decrypted %v, expected %v", got, msg)
+	}
+}
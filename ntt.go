@@ -0,0 +1,108 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errNoNTT = errors.New("polynomial: the modulus has no root of unity of the required power-of-two order")
+
+// nttRoot() returns a primitive n-th root of unity modulo a prime m, for n a power of two dividing m - 1
+// a^((m-1)/n) has order n exactly when its (n/2)-th power is -1, which holds for half of all a
+func nttRoot(n int, m *big.Int) (*big.Int, error) {
+	one := big.NewInt(1)
+	mm1 := new(big.Int).Sub(m, one)
+	e, r := new(big.Int).QuoRem(mm1, big.NewInt(int64(n)), new(big.Int))
+	if r.Sign() != 0 || n < 1 {
+		return nil, errNoNTT
+	}
+	if n == 1 {
+		return one, nil
+	}
+	half := big.NewInt(int64(n / 2))
+	for a := int64(2); a < 1000; a++ {
+		w := new(big.Int).Exp(big.NewInt(a), e, m)
+		if new(big.Int).Exp(w, half, m).Cmp(mm1) == 0 {
+			return w, nil
+		}
+	}
+	return nil, errNoNTT
+}
+
+// ntt() transforms a in place into (a(w^0), a(w^1), ..., a(w^(n-1))), where len(a) = n is a power of two
+// and w is a primitive n-th root of unity modulo m (iterative Cooley-Tukey)
+func ntt(a []*big.Int, w, m *big.Int) {
+	n := len(a)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+	t := new(big.Int)
+	for size := 2; size <= n; size <<= 1 {
+		step := new(big.Int).Exp(w, big.NewInt(int64(n/size)), m)
+		for start := 0; start < n; start += size {
+			tw := big.NewInt(1)
+			for k := 0; k < size/2; k++ {
+				u, v := a[start+k], a[start+k+size/2]
+				t.Mul(v, tw).Mod(t, m)
+				v.Sub(u, t).Mod(v, m)
+				u.Add(u, t).Mod(u, m)
+				tw.Mul(tw, step).Mod(tw, m)
+			}
+		}
+	}
+}
+
+// inverseNTT() undoes ntt() in place: it transforms with w^-1 and divides by n
+func inverseNTT(a []*big.Int, w, m *big.Int) {
+	ntt(a, new(big.Int).ModInverse(w, m), m)
+	inv := new(big.Int).ModInverse(big.NewInt(int64(len(a))), m)
+	for _, c := range a {
+		c.Mul(c, inv).Mod(c, m)
+	}
+}
+
+// nttPlan() returns the transform size for a product of la by lb coefficients modulo m and its root of unity,
+// or a nil root when m is not a prime with a root of unity of that order
+func nttPlan(la, lb int, m *big.Int) (int, *big.Int) {
+	n := 1
+	for n < la+lb-1 {
+		n <<= 1
+	}
+	if !m.ProbablyPrime(20) {
+		return n, nil
+	}
+	w, err := nttRoot(n, m)
+	if err != nil {
+		return n, nil
+	}
+	return n, w
+}
+
+// mulNTT() returns the untrimmed product of P and Q (reduced modulo m) through transforms of size n with the
+// root w from nttPlan()
+func mulNTT(p, q []*big.Int, n int, w, m *big.Int) Poly {
+	a, b := make([]*big.Int, n), make([]*big.Int, n)
+	for i := range a {
+		a[i], b[i] = new(big.Int), new(big.Int)
+		if i < len(p) {
+			a[i].Mod(p[i], m)
+		}
+		if i < len(q) {
+			b[i].Mod(q[i], m)
+		}
+	}
+	ntt(a, w, m)
+	ntt(b, w, m)
+	for i := range a {
+		a[i].Mul(a[i], b[i]).Mod(a[i], m)
+	}
+	inverseNTT(a, w, m)
+	return a[:len(p)+len(q)-1]
+}
@@ -0,0 +1,43 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNTT(t *testing.T) {
+	m := big.NewInt(998244353) // 119 * 2^23 + 1
+	for _, n := range []int{1, 2, 8, 64} {
+		w, err := nttRoot(n, m)
+		if err != nil {
+			t.Fatalf("nttRoot(%d) failed: %v", n, err)
+		}
+		p := RandomPoly(int64(n-1), 40)
+		p.sanitize(m)
+		a := make([]*big.Int, n)
+		for i := range a {
+			a[i] = p.Coeff(i)
+		}
+		ntt(a, w, m)
+		x := big.NewInt(1)
+		for i := range a {
+			if y := p.Eval(x, m); a[i].Cmp(y) != 0 {
+				t.Errorf("ntt()[%d] = %v, want P(w^%d) = %v", i, a[i], i, y)
+			}
+			x.Mul(x, w).Mod(x, m)
+		}
+		inverseNTT(a, w, m)
+		for i := range a {
+			if a[i].Cmp(p.Coeff(i)) != 0 {
+				t.Errorf("inverseNTT() does not restore coefficient %d", i)
+			}
+		}
+	}
+	// 2^24 does not divide 998244352, and 4 does not divide 6
+	if _, err := nttRoot(1<<24, m); err == nil {
+		t.Errorf("nttRoot(2^24) should fail")
+	}
+	if _, err := nttRoot(4, big.NewInt(7)); err == nil {
+		t.Errorf("nttRoot(4) modulo 7 should fail")
+	}
+}
@@ -0,0 +1,35 @@
+package polynomial
+
+import "math/big"
+
+// RandomMask() returns a polynomial of the given degree with uniformly random coefficients in [0, m)
+// The coefficients are drawn from crypto/rand, so the mask can be used to hide evaluations
+func RandomMask(degree int, m *big.Int) Poly {
+	size := m.BitLen()/8 + 9 // 64 extra bits make the modulo bias negligible
+	p := make(Poly, degree+1)
+	for i := range p {
+		p[i] = RandomBigInt(size)
+		p[i].Mod(p[i], m)
+	}
+	p.trim()
+	return p
+}
+
+// EvalBlinded() returns P(x) masked by the evaluation of R, i.e. P(x) + R(x) mod m,
+// and the unblinding value -R(x) mod m
+// The party holding the unblinding value recovers P(x) with Unblind()
+func EvalBlinded(p Poly, x *big.Int, r Poly, m *big.Int) (masked, unblind *big.Int) {
+	rx := r.Eval(x, m)
+	masked = p.Eval(x, m)
+	masked.Add(masked, rx)
+	masked.Mod(masked, m)
+	unblind = rx.Neg(rx)
+	unblind.Mod(unblind, m)
+	return
+}
+
+// Unblind() removes the mask from a value returned by EvalBlinded()
+func Unblind(masked, unblind, m *big.Int) *big.Int {
+	v := new(big.Int).Add(masked, unblind)
+	return v.Mod(v, m)
+}
@@ -0,0 +1,24 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestEvalBlinded(t *testing.T) {
+	m := big.NewInt(179424691)
+	p := NewPolyInts(43, 53, 45, 63, 43, 55, 75)
+	for i := 0; i < 10; i++ {
+		r := RandomMask(6, m)
+		x := big.NewInt(int64(1000 + i))
+		masked, unblind := EvalBlinded(p, x, r, m)
+		if v := Unblind(masked, unblind, m); v.Cmp(p.Eval(x, m)) != 0 {
+			t.Errorf("Unblind(EvalBlinded(%v, %v)) = %v, want %v", p, x, v, p.Eval(x, m))
+		}
+		for j := 0; j <= r.GetDegree(); j++ {
+			if r[j].Sign() < 0 || r[j].Cmp(m) >= 0 {
+				t.Errorf("RandomMask() coefficient %v is out of [0, %v)", r[j], m)
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package polynomial
+
+import "sort"
+
+// Ordering compares two polynomials and returns -1, 0, or 1 like Compare()
+type Ordering func(p, q Poly) int
+
+// CompareOrder is the ordering of Compare(): degree, then signed coefficients from the constant term
+func CompareOrder(p, q Poly) int {
+	return p.Compare(&q)
+}
+
+// DegreeLexAbs orders by degree, then by the absolute values of the coefficients from the leading one
+// Two polynomials that only differ in signs are ordered by the signed coefficients from the leading one
+func DegreeLexAbs(p, q Poly) int {
+	if c := cmpDegree(p, q); c != 0 {
+		return c
+	}
+	for i := p.GetDegree(); i >= 0; i-- {
+		if c := p[i].CmpAbs(q[i]); c != 0 {
+			return c
+		}
+	}
+	for i := p.GetDegree(); i >= 0; i-- {
+		if c := p[i].Cmp(q[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// DegreeLeading orders by degree, then by the (signed) leading coefficient only
+// Polynomials with the same degree and leading coefficient compare equal
+func DegreeLeading(p, q Poly) int {
+	if c := cmpDegree(p, q); c != 0 {
+		return c
+	}
+	return p[p.GetDegree()].Cmp(q[q.GetDegree()])
+}
+
+func cmpDegree(p, q Poly) int {
+	switch {
+	case p.GetDegree() > q.GetDegree():
+		return 1
+	case p.GetDegree() < q.GetDegree():
+		return -1
+	}
+	return 0
+}
+
+// SortPolys() sorts ps in increasing order (stable, so equal polynomials keep their order)
+func SortPolys(ps []Poly, ord Ordering) {
+	sort.SliceStable(ps, func(i, j int) bool { return ord(ps[i], ps[j]) < 0 })
+}
@@ -0,0 +1,58 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	cases := []struct {
+		p, q Poly
+		ans  bool
+	}{
+		{NewPolyInts(1, 2, 3), NewPolyInts(1, 2, 3), true},
+		{NewPolyInts(1, 2, 3), NewPolyInts(1, 2, -3), false},
+		{NewPolyInts(1, 2), NewPolyInts(1, 2, 3), false},
+		{Poly{big.NewInt(1), big.NewInt(0)}, NewPolyInts(1), true},
+		{NewPolyInts(0), Poly{}, true},
+	}
+	for _, c := range cases {
+		if res := c.p.Equal(c.q); res != c.ans {
+			t.Errorf("%v == %v should be %v", c.p, c.q, c.ans)
+		}
+	}
+}
+
+func TestOrderings(t *testing.T) {
+	cases := []struct {
+		ord Ordering
+		ps  []Poly
+		ans []Poly
+	}{
+		{
+			CompareOrder,
+			[]Poly{NewPolyInts(2, 1), NewPolyInts(9), NewPolyInts(1, 5)},
+			[]Poly{NewPolyInts(9), NewPolyInts(1, 5), NewPolyInts(2, 1)},
+		},
+		{
+			DegreeLexAbs,
+			[]Poly{NewPolyInts(2, -3), NewPolyInts(9), NewPolyInts(1, 5), NewPolyInts(2, 3)},
+			[]Poly{NewPolyInts(9), NewPolyInts(2, -3), NewPolyInts(2, 3), NewPolyInts(1, 5)},
+		},
+		{
+			DegreeLeading,
+			[]Poly{NewPolyInts(5, 3, 1), NewPolyInts(0, 4), NewPolyInts(1, 3, 1), NewPolyInts(7, -4)},
+			[]Poly{NewPolyInts(7, -4), NewPolyInts(0, 4), NewPolyInts(5, 3, 1), NewPolyInts(1, 3, 1)},
+		},
+	}
+	for _, c := range cases {
+		ps := append([]Poly(nil), c.ps...)
+		SortPolys(ps, c.ord)
+		for i := range ps {
+			if !ps[i].Equal(c.ans[i]) {
+				t.Errorf("sorting %v gives %v, want %v", c.ps, ps, c.ans)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package polynomial
+
+import "math/big"
+
+// LegendreP() returns the Legendre polynomial P_n as num / den with den = 2^n
+// The numerator 2^n P_n has integer coefficients, and
+// (k + 1) P_(k+1) = (2k + 1) x P_k - k P_(k-1) becomes an exact recurrence over Z when scaled
+func LegendreP(n int) (num Poly, den *big.Int) {
+	num = recur(n, NewPolyInts(1), NewPolyInts(0, 2), func(k int, prev, cur Poly) Poly {
+		a := cur.Clone(1).Mul(NewPolyInts(2*(2*k+1)), nil)
+		b := prev.Clone(0).Mul(NewPolyInts(4*k), nil)
+		q, _ := a.Sub(b, nil).Div(NewPolyInts(k+1), nil)
+		return q
+	})
+	return num, new(big.Int).Lsh(big.NewInt(1), uint(n))
+}
+
+// HermiteH() returns the physicists' Hermite polynomial
+// H_0 = 1, H_1 = 2x, H_(k+1) = 2x H_k - 2k H_(k-1)
+func HermiteH(n int) Poly {
+	return recur(n, NewPolyInts(1), NewPolyInts(0, 2), func(k int, prev, cur Poly) Poly {
+		return cur.Clone(1).Mul(NewPolyInts(2), nil).Sub(prev.Clone(0).Mul(NewPolyInts(2*k), nil), nil)
+	})
+}
+
+// HermiteHe() returns the probabilists' Hermite polynomial, He_n(x) = 2^(-n/2) H_n(x / sqrt 2)
+// He_0 = 1, He_1 = x, He_(k+1) = x He_k - k He_(k-1)
+func HermiteHe(n int) Poly {
+	return recur(n, NewPolyInts(1), NewPolyInts(0, 1), func(k int, prev, cur Poly) Poly {
+		return cur.Clone(1).Sub(prev.Clone(0).Mul(NewPolyInts(k), nil), nil)
+	})
+}
+
+// LaguerreL() returns the Laguerre polynomial L_n as num / den with den = n!
+// M_k = k! L_k satisfies M_(k+1) = (2k + 1 - x) M_k - k^2 M_(k-1)
+func LaguerreL(n int) (num Poly, den *big.Int) {
+	num = recur(n, NewPolyInts(1), NewPolyInts(1, -1), func(k int, prev, cur Poly) Poly {
+		a := cur.Clone(0).Mul(NewPolyInts(2*k+1, -1), nil)
+		return a.Sub(prev.Clone(0).Mul(NewPolyInts(k*k), nil), nil)
+	})
+	return num, new(big.Int).MulRange(1, int64(n))
+}
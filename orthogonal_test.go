@@ -0,0 +1,74 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestLegendreP(t *testing.T) {
+	cases := []struct {
+		n   int
+		num Poly
+	}{
+		{0, NewPolyInts(1)},
+		{1, NewPolyInts(0, 2)},
+		// P_2 = (3x^2 - 1) / 2
+		{2, NewPolyInts(-2, 0, 6)},
+		// P_3 = (5x^3 - 3x) / 2
+		{3, NewPolyInts(0, -12, 0, 20)},
+		// P_4 = (35x^4 - 30x^2 + 3) / 8
+		{4, NewPolyInts(6, 0, -60, 0, 70)},
+	}
+	for _, c := range cases {
+		num, den := LegendreP(c.n)
+		if num.Compare(&c.num) != 0 || den.Cmp(new(big.Int).Lsh(big.NewInt(1), uint(c.n))) != 0 {
+			t.Errorf("LegendreP(%d) = %v / %v, want %v / 2^%d", c.n, num, den, c.num, c.n)
+		}
+		// P_n(1) = 1
+		if y := num.Eval(big.NewInt(1), nil); y.Cmp(den) != 0 {
+			t.Errorf("LegendreP(%d) at 1 is %v / %v", c.n, y, den)
+		}
+	}
+}
+
+func TestHermite(t *testing.T) {
+	cases := []struct {
+		n     int
+		h, he Poly
+	}{
+		{0, NewPolyInts(1), NewPolyInts(1)},
+		{1, NewPolyInts(0, 2), NewPolyInts(0, 1)},
+		{2, NewPolyInts(-2, 0, 4), NewPolyInts(-1, 0, 1)},
+		{3, NewPolyInts(0, -12, 0, 8), NewPolyInts(0, -3, 0, 1)},
+		{4, NewPolyInts(12, 0, -48, 0, 16), NewPolyInts(3, 0, -6, 0, 1)},
+	}
+	for _, c := range cases {
+		if got := HermiteH(c.n); got.Compare(&c.h) != 0 {
+			t.Errorf("HermiteH(%d) = %v, want %v", c.n, got, c.h)
+		}
+		if got := HermiteHe(c.n); got.Compare(&c.he) != 0 {
+			t.Errorf("HermiteHe(%d) = %v, want %v", c.n, got, c.he)
+		}
+	}
+}
+
+func TestLaguerreL(t *testing.T) {
+	cases := []struct {
+		n   int
+		num Poly
+		den int64
+	}{
+		{0, NewPolyInts(1), 1},
+		{1, NewPolyInts(1, -1), 1},
+		// L_2 = (x^2 - 4x + 2) / 2
+		{2, NewPolyInts(2, -4, 1), 2},
+		// L_3 = (-x^3 + 9x^2 - 18x + 6) / 6
+		{3, NewPolyInts(6, -18, 9, -1), 6},
+	}
+	for _, c := range cases {
+		num, den := LaguerreL(c.n)
+		if num.Compare(&c.num) != 0 || den.Int64() != c.den {
+			t.Errorf("LaguerreL(%d) = %v / %v, want %v / %d", c.n, num, den, c.num, c.den)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	errPadeOrder = errors.New("polynomial: the order must be at least dNum + dDen + 1")
+	errNoPade    = errors.New("polynomial: the series has no Padé approximant of this type")
+)
+
+// Pade() returns N/D with deg N <= dNum, deg D <= dDen and N/D = series mod x^n, modulo a prime m
+// It runs the extended Euclidean algorithm on (x^n, series mod x^n) and stops at the first
+// remainder of degree <= dNum; every remainder r_i satisfies r_i = t_i * series mod x^n
+// It fails if the resulting denominator is too large or vanishes at 0
+func Pade(series Poly, n, dNum, dDen int, m *big.Int) (RationalFunc, error) {
+	if n < dNum+dDen+1 {
+		return RationalFunc{}, errPadeOrder
+	}
+	r0 := NewPolyInts(1).MulXn(n)
+	r1 := series.Trunc(n)
+	r1.sanitize(m)
+	t0, t1 := NewPolyInts(0), NewPolyInts(1)
+	for !r1.isZero() && r1.GetDegree() > dNum {
+		q, r := r0.Div(r1.Clone(0), m)
+		r0, r1 = r1, r
+		t0, t1 = t1, t0.Sub(q.Mul(t1.Clone(0), m), m)
+	}
+	if t1.GetDegree() > dDen {
+		return RationalFunc{}, errNoPade
+	}
+	if d := t1.ConstantTerm(); d.Mod(d, m).Sign() == 0 {
+		return RationalFunc{}, errNoPade
+	}
+	return NewRationalFunc(r1, t1, m)
+}
@@ -0,0 +1,41 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestPade(t *testing.T) {
+	m := big.NewInt(998244353)
+	// the Fibonacci generating function 1 / (1 - x - x^2) is recovered from 10 terms
+	fib := NewPolyInts(1, 1, 2, 3, 5, 8, 13, 21, 34, 55)
+	r, err := Pade(fib, 10, 4, 5, m)
+	if err != nil {
+		t.Fatalf("Pade() failed: %v", err)
+	}
+	want, _ := NewRationalFunc(NewPolyInts(1), NewPolyInts(1, -1, -1), m)
+	if r.Num.Compare(&want.Num) != 0 || r.Den.Compare(&want.Den) != 0 {
+		t.Errorf("Padé approximant of %v is %v, want %v", fib, r, want)
+	}
+
+	// any [2/2] approximant agrees with the series up to x^4
+	s := NewPolyInts(3, 1, 4, 1, 5, 9, 2, 6)
+	r, err = Pade(s, 5, 2, 2, m)
+	if err != nil {
+		t.Fatalf("Pade() failed: %v", err)
+	}
+	inv, _ := r.Den.InverseSeries(5, m)
+	approx := r.Num.Clone(0).Mul(inv, m).Trunc(5)
+	exact := s.Trunc(5)
+	exact.sanitize(m)
+	if approx.Compare(&exact) != 0 {
+		t.Errorf("%v = %v mod x^5, want %v", r, approx, exact)
+	}
+	if r.Num.GetDegree() > 2 || r.Den.GetDegree() > 2 {
+		t.Errorf("%v exceeds the [2/2] type", r)
+	}
+
+	if _, err := Pade(s, 3, 2, 2, m); err == nil {
+		t.Errorf("Pade() with an order too small should fail")
+	}
+}
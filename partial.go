@@ -0,0 +1,108 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Factor represents P^E in a factored denominator
+type Factor struct {
+	P Poly
+	E int
+}
+
+// PartialFraction represents Num / Den^Power with deg Num < deg Den
+type PartialFraction struct {
+	Num, Den Poly
+	Power    int
+}
+
+var (
+	errFactorization = errors.New("polynomial: the factors are not a factorization of the denominator")
+	errNotCoprime    = errors.New("polynomial: the factors are not pairwise coprime")
+)
+
+// invMod() returns a^-1 mod f modulo a prime m (extended Euclidean algorithm)
+func invMod(a, f Poly, m *big.Int) (Poly, error) {
+	_, r1 := a.Clone(0).Div(f.Clone(0), m)
+	r0 := f.Clone(0)
+	r0.sanitize(m)
+	t0, t1 := NewPolyInts(0), NewPolyInts(1)
+	for !r1.isZero() {
+		q, r := r0.Div(r1.Clone(0), m)
+		r0, r1 = r1, r
+		t0, t1 = t1, t0.Sub(q.Mul(t1.Clone(0), m), m)
+	}
+	if r0.GetDegree() != 0 {
+		return nil, errNotInvertible
+	}
+	c := new(big.Int).ModInverse(r0[0], m)
+	_, inv := t0.Mul(Poly{c}, m).Div(f.Clone(0), m)
+	return inv, nil
+}
+
+// PartialFractions() decomposes r into q + sum of Num / P^j modulo a prime m
+// factors must be pairwise coprime and their product must be a multiple of r.Den
+// The fractions are returned factor by factor, with increasing powers
+func (r RationalFunc) PartialFractions(factors []Factor, m *big.Int) (Poly, []PartialFraction, error) {
+	powers := make([]Poly, len(factors))
+	for i, f := range factors {
+		if f.E < 1 || f.P.GetDegree() < 1 {
+			return nil, nil, errFactorization
+		}
+		powers[i] = NewPolyInts(1)
+		for j := 0; j < f.E; j++ {
+			powers[i] = powers[i].Mul(f.P.Clone(0), m)
+		}
+	}
+	d := product(powers, m)
+	scale, rem := d.Clone(0).Div(r.Den.Clone(0), m)
+	if !rem.isZeroMod(m) || d.isZero() {
+		return nil, nil, errFactorization
+	}
+	q, n := r.Num.Clone(0).Mul(scale, m).Div(d.Clone(0), m)
+	var fracs []PartialFraction
+	for i, f := range factors {
+		// A_i = N * (D / P_i^E_i)^-1 mod P_i^E_i is the numerator over P_i^E_i
+		cofactor, _ := d.Clone(0).Div(powers[i].Clone(0), m)
+		inv, err := invMod(cofactor, powers[i], m)
+		if err != nil {
+			return nil, nil, errNotCoprime
+		}
+		_, a := n.Clone(0).Mul(inv, m).Div(powers[i].Clone(0), m)
+		// write A_i in base P_i: A_i = sum a_j P_i^j gives a_j / P_i^(E_i - j)
+		digits := make([]Poly, f.E)
+		for j := 0; j < f.E; j++ {
+			a, digits[j] = a.Div(f.P.Clone(0), m)
+		}
+		for k := 1; k <= f.E; k++ {
+			if c := digits[f.E-k]; !c.isZero() {
+				fracs = append(fracs, PartialFraction{c, f.P.Clone(0), k})
+			}
+		}
+	}
+	return q, fracs, nil
+}
+
+// SimpleFractions() decomposes r into q + sum of c_i / (x - roots_i) modulo a prime m
+// The roots must be distinct and r.Den must divide the product of (x - roots_i)
+// c_i = N(roots_i) / D'(roots_i), where N / D is the proper part of r over D = prod (x - roots_i)
+func (r RationalFunc) SimpleFractions(roots []*big.Int, m *big.Int) (Poly, []*big.Int, error) {
+	d := RootPoly(roots, m)
+	scale, rem := d.Clone(0).Div(r.Den.Clone(0), m)
+	if !rem.isZeroMod(m) {
+		return nil, nil, errFactorization
+	}
+	q, n := r.Num.Clone(0).Mul(scale, m).Div(d.Clone(0), m)
+	dd := d.Derivative(m)
+	cs := make([]*big.Int, len(roots))
+	for i, x := range roots {
+		inv := new(big.Int).ModInverse(dd.Eval(x, m), m)
+		if inv == nil {
+			return nil, nil, errNotCoprime
+		}
+		cs[i] = inv.Mul(inv, n.Eval(x, m))
+		cs[i].Mod(cs[i], m)
+	}
+	return q, cs, nil
+}
@@ -0,0 +1,68 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestPartialFractions(t *testing.T) {
+	m := big.NewInt(101)
+	cases := []struct {
+		num, den Poly
+		factors  []Factor
+	}{
+		{NewPolyInts(1), NewPolyInts(-1, 0, 1), []Factor{{NewPolyInts(-1, 1), 1}, {NewPolyInts(1, 1), 1}}},
+		{NewPolyInts(1, 0, 0, 1, 2), NewPolyInts(1, -1, -1, 1), []Factor{{NewPolyInts(-1, 1), 2}, {NewPolyInts(1, 1), 1}}},
+		{NewPolyInts(5, 0, 3), NewPolyInts(1, 0, 2, 0, 1), []Factor{{NewPolyInts(1, 0, 1), 2}}},
+		// the fraction reduces to 1 / (x + 2), a factor of the given product
+		{NewPolyInts(3, 1), NewPolyInts(6, 5, 1), []Factor{{NewPolyInts(2, 1), 1}, {NewPolyInts(3, 1), 1}}},
+	}
+	for _, c := range cases {
+		r, _ := NewRationalFunc(c.num, c.den, m)
+		q, fracs, err := r.PartialFractions(c.factors, m)
+		if err != nil {
+			t.Errorf("PartialFractions(%v) failed: %v", r, err)
+			continue
+		}
+		sum, _ := NewRationalFunc(q, NewPolyInts(1), m)
+		for _, f := range fracs {
+			if f.Num.GetDegree() >= f.Den.GetDegree() {
+				t.Errorf("%v / (%v)^%d is not proper", f.Num, f.Den, f.Power)
+			}
+			den := NewPolyInts(1)
+			for j := 0; j < f.Power; j++ {
+				den = den.Mul(f.Den.Clone(0), m)
+			}
+			g, _ := NewRationalFunc(f.Num, den, m)
+			sum = sum.Add(g, m)
+		}
+		if sum.Num.Compare(&r.Num) != 0 || sum.Den.Compare(&r.Den) != 0 {
+			t.Errorf("the fractions of %v add up to %v", r, sum)
+		}
+	}
+
+	r, _ := NewRationalFunc(NewPolyInts(1), NewPolyInts(-1, 0, 1), m)
+	if _, _, err := r.PartialFractions([]Factor{{NewPolyInts(-1, 1), 1}}, m); err == nil {
+		t.Errorf("an incomplete factorization should be rejected")
+	}
+	if _, _, err := r.PartialFractions([]Factor{{NewPolyInts(-1, 0, 1), 1}, {NewPolyInts(1, 1), 1}}, m); err == nil {
+		t.Errorf("factors sharing a root should be rejected")
+	}
+}
+
+func TestSimpleFractions(t *testing.T) {
+	m := big.NewInt(101)
+	// (x^3 + 2) / (x^2 - 1) = x + (3/2) / (x - 1) - (1/2) / (x + 1)
+	r, _ := NewRationalFunc(NewPolyInts(2, 0, 0, 1), NewPolyInts(-1, 0, 1), m)
+	q, cs, err := r.SimpleFractions(bigs(1, 100), m)
+	if err != nil {
+		t.Fatalf("SimpleFractions() failed: %v", err)
+	}
+	wantQ, wantC := NewPolyInts(0, 1), bigs(52, 50)
+	if q.Compare(&wantQ) != 0 || cs[0].Cmp(wantC[0]) != 0 || cs[1].Cmp(wantC[1]) != 0 {
+		t.Errorf("SimpleFractions(%v) = %v, %v, want %v, %v", r, q, cs, wantQ, wantC)
+	}
+	if _, _, err := r.SimpleFractions(bigs(1, 1, 100), m); err == nil {
+		t.Errorf("repeated roots should be rejected")
+	}
+}
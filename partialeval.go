@@ -0,0 +1,33 @@
+package polynomial
+
+import "math/big"
+
+// PartialEval() substitutes assignments[v] for every assigned variable v and returns the polynomial in the
+// remaining variables, renumbered in their original order: assigning x2 in P(x1, x2, x3) gives Q(x1, x3)
+// Panics if a variable is out of range
+func (p MultiPoly) PartialEval(assignments map[int]*big.Int, m *big.Int) MultiPoly {
+	keep := make([]int, 0, p.NVars)
+	for v := 0; v < p.NVars; v++ {
+		if _, ok := assignments[v]; !ok {
+			keep = append(keep, v)
+		}
+	}
+	if len(keep)+len(assignments) != p.NVars {
+		panic("polynomial: PartialEval() assigns a variable out of range")
+	}
+	a := newMultiAcc(len(keep))
+	e := make([]int, len(keep))
+	for _, t := range p.Terms {
+		c := new(big.Int).Set(t.Coeff)
+		for v, val := range assignments {
+			if t.Exp[v] > 0 {
+				c.Mul(c, new(big.Int).Exp(val, big.NewInt(int64(t.Exp[v])), m))
+			}
+		}
+		for i, v := range keep {
+			e[i] = t.Exp[v]
+		}
+		a.add(e, c)
+	}
+	return a.result(m)
+}
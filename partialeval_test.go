@@ -0,0 +1,29 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMultiPolyPartialEval(t *testing.T) {
+	// 2 x1^2 x2 + 3 x2 x3^2 - x1 + 5
+	p := NewMultiPoly(3, mono(2, 2, 1, 0), mono(3, 0, 1, 2), mono(-1, 1, 0, 0), mono(5, 0, 0, 0))
+	cases := []struct {
+		a    map[int]*big.Int
+		m    *big.Int
+		want MultiPoly
+	}{
+		{map[int]*big.Int{}, nil, p},
+		// x2 = 2: 4 x1^2 + 6 x3^2 - x1 + 5
+		{map[int]*big.Int{1: big.NewInt(2)}, nil, NewMultiPoly(2, mono(4, 2, 0), mono(6, 0, 2), mono(-1, 1, 0), mono(5, 0, 0))},
+		// x1 = 1, x3 = -1: 5 x2 + 4
+		{map[int]*big.Int{0: big.NewInt(1), 2: big.NewInt(-1)}, nil, NewMultiPoly(1, mono(5, 1), mono(4, 0))},
+		{map[int]*big.Int{0: big.NewInt(1), 2: big.NewInt(-1)}, big.NewInt(3), NewMultiPoly(1, mono(2, 1), mono(1, 0))},
+		{map[int]*big.Int{0: big.NewInt(1), 1: big.NewInt(2), 2: big.NewInt(3)}, nil, NewMultiPoly(0, mono(62))},
+	}
+	for _, c := range cases {
+		if got := p.PartialEval(c.a, c.m); !got.Equal(c.want) {
+			t.Errorf("PartialEval(%v) mod %v = %v, expected %v", c.a, c.m, got, c.want)
+		}
+	}
+}
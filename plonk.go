@@ -0,0 +1,143 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	errVanishingNotExact = errors.New("polynomial: the constraint polynomial does not vanish on the domain")
+	errCosetShape        = errors.New("polynomial: coset size must be a multiple of the domain size")
+	errCosetShift        = errors.New("polynomial: the coset shift lies in the domain")
+	errDomainSize        = errors.New("polynomial: the domain size must be positive")
+)
+
+// VanishingPoly() returns Z_H(x) = x^n - 1, which vanishes on the subgroup H of order n
+// n must be positive (x^0 - 1 = 0 vanishes everywhere)
+func VanishingPoly(n int) Poly {
+	if n < 1 {
+		panic("polynomial: the vanishing polynomial needs a positive domain size")
+	}
+	z := make(Poly, n+1)
+	z[0] = big.NewInt(-1)
+	for i := 1; i < n; i++ {
+		z[i] = big.NewInt(0)
+	}
+	z[n] = big.NewInt(1)
+	return z
+}
+
+// DivideByVanishing() returns C(x) / (x^n - 1) and fails when the remainder is not zero
+func (p Poly) DivideByVanishing(n int, m *big.Int) (Poly, error) {
+	if n < 1 {
+		return nil, errDomainSize
+	}
+	quo, rem := p.Clone(0).Div(VanishingPoly(n), m)
+	if !rem.isZero() {
+		return nil, errVanishingNotExact
+	}
+	return quo, nil
+}
+
+// AggregateConstraints() returns sum alpha^i * cs[i] modulo m
+func AggregateConstraints(cs []Poly, alpha, m *big.Int) Poly {
+	acc := NewPolyInts(0)
+	a := big.NewInt(1)
+	for _, c := range cs {
+		acc = acc.Add(c.Clone(0).Mul(Poly{new(big.Int).Set(a)}, m), m)
+		a.Mul(a, alpha)
+		a.Mod(a, m)
+	}
+	return acc
+}
+
+// EvalCoset() returns p(shift * gen^i) for i = 0..size-1
+func (p Poly) EvalCoset(shift, gen *big.Int, size int, m *big.Int) []*big.Int {
+	out := make([]*big.Int, size)
+	x := new(big.Int).Mod(shift, m)
+	for i := 0; i < size; i++ {
+		out[i] = p.Eval(x, m)
+		x.Mul(x, gen)
+		x.Mod(x, m)
+	}
+	return out
+}
+
+// InterpolateCoset() is the inverse of EvalCoset() for a gen of order len(evals)
+// It runs the O(n^2) inverse DFT of the values and then undoes the shift
+func InterpolateCoset(evals []*big.Int, shift, gen, m *big.Int) Poly {
+	n := len(evals)
+	genInv := new(big.Int).ModInverse(gen, m)
+	nInv := new(big.Int).ModInverse(big.NewInt(int64(n)), m)
+	shiftInv := new(big.Int).ModInverse(shift, m)
+	p := make(Poly, n)
+	w := big.NewInt(1)          // gen^-j
+	s := new(big.Int).Set(nInv) // shift^-j / n
+	for j := 0; j < n; j++ {
+		c := big.NewInt(0)
+		x := big.NewInt(1) // gen^-ij
+		for i := 0; i < n; i++ {
+			t := new(big.Int).Mul(evals[i], x)
+			c.Add(c, t)
+			x.Mul(x, w)
+			x.Mod(x, m)
+		}
+		c.Mul(c, s)
+		p[j] = c.Mod(c, m)
+		w.Mul(w, genInv)
+		w.Mod(w, m)
+		s.Mul(s, shiftInv)
+		s.Mod(s, m)
+	}
+	p.trim()
+	return p
+}
+
+// PlonkQuotient() computes t(x) = (sum alpha^i C_i(x)) / Z_H(x) from evaluations of every C_i
+// over the coset {shift * gen^j} of size N, where H has order n and N is a multiple of n
+// The division is done point-wise on the coset (Z_H never vanishes there) and the result is
+// interpolated back; since deg C < N, t has degree < N - n exactly when Z_H divides C
+func PlonkQuotient(cosetEvals [][]*big.Int, alpha, shift, gen *big.Int, n int, m *big.Int) (Poly, error) {
+	if len(cosetEvals) == 0 {
+		return NewPolyInts(0), nil
+	}
+	size := len(cosetEvals[0])
+	if n <= 0 || size%n != 0 {
+		return nil, errCosetShape
+	}
+	bign := big.NewInt(int64(n))
+	zh := make([]*big.Int, size)
+	x := new(big.Int).Mod(shift, m)
+	for j := 0; j < size; j++ {
+		z := new(big.Int).Exp(x, bign, m)
+		z.Sub(z, big.NewInt(1))
+		z.Mod(z, m)
+		if z.Sign() == 0 {
+			return nil, errCosetShift
+		}
+		zh[j] = z.ModInverse(z, m)
+		x.Mul(x, gen)
+		x.Mod(x, m)
+	}
+	t := make([]*big.Int, size)
+	for j := range t {
+		acc := big.NewInt(0)
+		a := big.NewInt(1)
+		for _, evals := range cosetEvals {
+			if len(evals) != size {
+				return nil, errCosetShape
+			}
+			v := new(big.Int).Mul(evals[j], a)
+			acc.Add(acc, v)
+			a.Mul(a, alpha)
+			a.Mod(a, m)
+		}
+		acc.Mul(acc, zh[j])
+		t[j] = acc.Mod(acc, m)
+	}
+	q := InterpolateCoset(t, shift, gen, m)
+	if q.GetDegree() >= size-n && !q.isZero() {
+		return nil, errVanishingNotExact
+	}
+	return q, nil
+}
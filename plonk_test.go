@@ -0,0 +1,67 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDivideByVanishing(t *testing.T) {
+	m := big.NewInt(257)
+	c := VanishingPoly(4).Mul(NewPolyInts(7, 5, 1), m)
+	q, err := c.DivideByVanishing(4, m)
+	if err != nil || q.Compare(&Poly{big.NewInt(7), big.NewInt(5), big.NewInt(1)}) != 0 {
+		t.Errorf("(%v) / (x^4 - 1) = %v (error: %v)", c, q, err)
+	}
+	c = c.Add(NewPolyInts(1), m)
+	if _, err := c.DivideByVanishing(4, m); err == nil {
+		t.Errorf("%v is not divisible by x^4 - 1 but DivideByVanishing() succeeds", c)
+	}
+	// the smallest domain {1}
+	if z := VanishingPoly(1); z.Compare(&Poly{big.NewInt(-1), big.NewInt(1)}) != 0 {
+		t.Errorf("VanishingPoly(1) = %v, want x - 1", z)
+	}
+	if _, err := c.DivideByVanishing(0, m); err != errDomainSize {
+		t.Errorf("DivideByVanishing(0): error %v, expected errDomainSize", err)
+	}
+}
+
+func TestInterpolateCoset(t *testing.T) {
+	m := big.NewInt(257)
+	gen := new(big.Int).Exp(big.NewInt(3), big.NewInt(16), m) // order 16
+	shift := big.NewInt(3)
+	p := NewPolyInts(43, 53, 45, 63, 43, 55, 75, 11, 1, 2, 3, 4)
+	evals := p.EvalCoset(shift, gen, 16, m)
+	res := InterpolateCoset(evals, shift, gen, m)
+	if res.Compare(&p) != 0 {
+		t.Errorf("InterpolateCoset(EvalCoset(%v)) = %v", p, res)
+	}
+}
+
+func TestPlonkQuotient(t *testing.T) {
+	m := big.NewInt(257)
+	gen := new(big.Int).Exp(big.NewInt(3), big.NewInt(16), m) // order 16
+	shift := big.NewInt(3)
+	zh := VanishingPoly(4)
+	c1 := zh.Mul(NewPolyInts(1, 0, 1), m)
+	c2 := zh.Mul(NewPolyInts(7, 5), m)
+	alpha := big.NewInt(10)
+	evals := [][]*big.Int{c1.EvalCoset(shift, gen, 16, m), c2.EvalCoset(shift, gen, 16, m)}
+	q, err := PlonkQuotient(evals, alpha, shift, gen, 4, m)
+	ans := NewPolyInts(71, 50, 1)
+	if err != nil || q.Compare(&ans) != 0 {
+		t.Errorf("PlonkQuotient() = %v, want %v (error: %v)", q, ans, err)
+	}
+	agg := AggregateConstraints([]Poly{c1, c2}, alpha, m)
+	if direct, err := agg.DivideByVanishing(4, m); err != nil || direct.Compare(&ans) != 0 {
+		t.Errorf("AggregateConstraints() / Z_H = %v, want %v (error: %v)", direct, ans, err)
+	}
+
+	bad := c2.Add(NewPolyInts(0, 1), m)
+	evals[1] = bad.EvalCoset(shift, gen, 16, m)
+	if _, err := PlonkQuotient(evals, alpha, shift, gen, 4, m); err == nil {
+		t.Errorf("PlonkQuotient() accepts a constraint that does not vanish on H")
+	}
+	if _, err := PlonkQuotient(evals, alpha, big.NewInt(1), gen, 4, m); err == nil {
+		t.Errorf("PlonkQuotient() accepts a coset shift inside H")
+	}
+}
@@ -11,6 +11,21 @@ type Point struct {
 	x, y *big.Int
 }
 
+// NewPoint() returns the point (x, y) holding copies of x and y
+func NewPoint(x, y *big.Int) Point {
+	return Point{new(big.Int).Set(x), new(big.Int).Set(y)}
+}
+
+// X() returns a copy of the x coordinate
+func (p Point) X() *big.Int {
+	return new(big.Int).Set(p.x)
+}
+
+// Y() returns a copy of the y coordinate
+func (p Point) Y() *big.Int {
+	return new(big.Int).Set(p.y)
+}
+
 // Points type represents a set of Point type
 type Points []Point
 
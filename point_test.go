@@ -6,7 +6,7 @@ import (
 )
 
 // Printing example for Point data sturcture
-func ExamplePrintPoint() {
+func ExamplePoint_String() {
 	p := Point{big.NewInt(1), big.NewInt(2)}
 	fmt.Println(p)
 	q := Point{big.NewInt(1234567890), big.NewInt(987654321)}
@@ -17,7 +17,7 @@ func ExamplePrintPoint() {
 }
 
 // Printing example for Points data structure
-func ExamplePrintPoints() {
+func ExamplePoints_String() {
 	ps := Points{
 		Point{big.NewInt(1), big.NewInt(2)},
 		Point{big.NewInt(12345), big.NewInt(54321)},
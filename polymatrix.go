@@ -0,0 +1,336 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// PolyMatrix is a matrix whose entries are polynomials, indexed as A[row][col]
+// The normal forms and the determinant work over Z_m[x] for a prime m
+type PolyMatrix [][]Poly
+
+var errMatrixShape = errors.New("polynomial: matrix dimensions do not match")
+
+// NewPolyMatrix() returns the rows x cols zero matrix
+func NewPolyMatrix(rows, cols int) PolyMatrix {
+	a := make(PolyMatrix, rows)
+	for i := range a {
+		a[i] = make([]Poly, cols)
+		for j := range a[i] {
+			a[i][j] = NewPolyInts(0)
+		}
+	}
+	return a
+}
+
+// IdentityPolyMatrix() returns the n x n identity matrix
+func IdentityPolyMatrix(n int) PolyMatrix {
+	a := NewPolyMatrix(n, n)
+	for i := 0; i < n; i++ {
+		a[i][i] = NewPolyInts(1)
+	}
+	return a
+}
+
+// Rows() and Cols() return the dimensions
+func (a PolyMatrix) Rows() int {
+	return len(a)
+}
+
+func (a PolyMatrix) Cols() int {
+	if len(a) == 0 {
+		return 0
+	}
+	return len(a[0])
+}
+
+// Clone() does deep-copy
+func (a PolyMatrix) Clone() PolyMatrix {
+	b := make(PolyMatrix, len(a))
+	for i := range a {
+		b[i] = make([]Poly, len(a[i]))
+		for j := range a[i] {
+			b[i][j] = a[i][j].Clone(0)
+		}
+	}
+	return b
+}
+
+// Equal() checks if A == B entry by entry
+func (a PolyMatrix) Equal(b PolyMatrix) bool {
+	if a.Rows() != b.Rows() || a.Cols() != b.Cols() {
+		return false
+	}
+	for i := range a {
+		for j := range a[i] {
+			if a[i][j].Compare(&b[i][j]) != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a PolyMatrix) String() string {
+	rows := make([]string, len(a))
+	for i, r := range a {
+		es := make([]string, len(r))
+		for j, e := range r {
+			es[j] = e.String()
+		}
+		rows[i] = strings.Join(es, " ")
+	}
+	return "{" + strings.Join(rows, "; ") + "}"
+}
+
+// Add() adds two matrices of the same size
+func (a PolyMatrix) Add(b PolyMatrix, m *big.Int) (PolyMatrix, error) {
+	if a.Rows() != b.Rows() || a.Cols() != b.Cols() {
+		return nil, errMatrixShape
+	}
+	c := NewPolyMatrix(a.Rows(), a.Cols())
+	for i := range a {
+		for j := range a[i] {
+			c[i][j] = a[i][j].Add(b[i][j], m)
+		}
+	}
+	return c, nil
+}
+
+// Mul() returns the matrix product A * B
+func (a PolyMatrix) Mul(b PolyMatrix, m *big.Int) (PolyMatrix, error) {
+	if a.Cols() != b.Rows() {
+		return nil, errMatrixShape
+	}
+	c := NewPolyMatrix(a.Rows(), b.Cols())
+	for i := 0; i < a.Rows(); i++ {
+		for j := 0; j < b.Cols(); j++ {
+			for k := 0; k < a.Cols(); k++ {
+				c[i][j] = c[i][j].Add(a[i][k].Clone(0).Mul(b[k][j].Clone(0), m), m)
+			}
+		}
+	}
+	return c, nil
+}
+
+// Det() returns the determinant of a square matrix over Z_m[x]
+// It uses Bareiss' fraction-free elimination, in which every division is exact
+func (a PolyMatrix) Det(m *big.Int) (Poly, error) {
+	n := a.Rows()
+	if n != a.Cols() {
+		return nil, errMatrixShape
+	}
+	if n == 0 {
+		return NewPolyInts(1), nil
+	}
+	b := a.Clone()
+	for i := range b {
+		for j := range b[i] {
+			b[i][j].sanitize(m)
+		}
+	}
+	neg := false
+	prev := NewPolyInts(1)
+	for k := 0; k < n-1; k++ {
+		if b[k][k].isZero() {
+			p := k + 1
+			for p < n && b[p][k].isZero() {
+				p++
+			}
+			if p == n {
+				return NewPolyInts(0), nil
+			}
+			b[k], b[p] = b[p], b[k]
+			neg = !neg
+		}
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				t := b[i][j].Clone(0).Mul(b[k][k].Clone(0), m)
+				t = t.Sub(b[i][k].Clone(0).Mul(b[k][j].Clone(0), m), m)
+				b[i][j], _ = t.Div(prev.Clone(0), m)
+			}
+		}
+		prev = b[k][k]
+	}
+	d := b[n-1][n-1]
+	if neg {
+		d = d.Neg()
+		d.sanitize(m)
+	}
+	return d, nil
+}
+
+// row and column operations shared by the normal forms
+// the callers apply every operation to the transformation matrices as well
+
+func (a PolyMatrix) swapRows(i, j int) {
+	a[i], a[j] = a[j], a[i]
+}
+
+func (a PolyMatrix) swapCols(i, j int) {
+	for r := range a {
+		a[r][i], a[r][j] = a[r][j], a[r][i]
+	}
+}
+
+// addRow() does row_i += c * row_j
+func (a PolyMatrix) addRow(i, j int, c Poly, m *big.Int) {
+	for k := range a[i] {
+		a[i][k] = a[i][k].Add(a[j][k].Clone(0).Mul(c.Clone(0), m), m)
+	}
+}
+
+// addCol() does col_i += c * col_j
+func (a PolyMatrix) addCol(i, j int, c Poly, m *big.Int) {
+	for r := range a {
+		a[r][i] = a[r][i].Add(a[r][j].Clone(0).Mul(c.Clone(0), m), m)
+	}
+}
+
+func (a PolyMatrix) scaleRow(i int, c *big.Int, m *big.Int) {
+	for k := range a[i] {
+		a[i][k] = a[i][k].Mul(Poly{new(big.Int).Set(c)}, m)
+	}
+}
+
+// HermiteForm() returns the row-style Hermite normal form H and a unimodular U with U * A = H
+// H is upper echelon, every pivot is monic and the entries above a pivot have a smaller degree
+func (a PolyMatrix) HermiteForm(m *big.Int) (h, u PolyMatrix) {
+	h = a.Clone()
+	u = IdentityPolyMatrix(a.Rows())
+	row := 0
+	for col := 0; col < h.Cols() && row < h.Rows(); col++ {
+		for {
+			// the entry of smallest degree in the column becomes the pivot
+			p := -1
+			for i := row; i < h.Rows(); i++ {
+				h[i][col].sanitize(m)
+				if !h[i][col].isZero() && (p < 0 || h[i][col].GetDegree() < h[p][col].GetDegree()) {
+					p = i
+				}
+			}
+			if p < 0 {
+				break
+			}
+			h.swapRows(row, p)
+			u.swapRows(row, p)
+			done := true
+			for i := row + 1; i < h.Rows(); i++ {
+				if h[i][col].isZero() {
+					continue
+				}
+				q, r := h[i][col].Clone(0).Div(h[row][col].Clone(0), m)
+				q = q.Neg()
+				h.addRow(i, row, q, m)
+				u.addRow(i, row, q, m)
+				if !r.isZero() {
+					done = false
+				}
+			}
+			if done {
+				break
+			}
+		}
+		if h[row][col].isZero() {
+			continue
+		}
+		inv := new(big.Int).ModInverse(h[row][col][h[row][col].GetDegree()], m)
+		h.scaleRow(row, inv, m)
+		u.scaleRow(row, inv, m)
+		for i := 0; i < row; i++ {
+			q, _ := h[i][col].Clone(0).Div(h[row][col].Clone(0), m)
+			q = q.Neg()
+			h.addRow(i, row, q, m)
+			u.addRow(i, row, q, m)
+		}
+		row++
+	}
+	return
+}
+
+// SmithForm() returns the Smith normal form S and unimodular U, V with U * A * V = S
+// S is diagonal with monic invariant factors s_1 | s_2 | ... followed by zeros
+func (a PolyMatrix) SmithForm(m *big.Int) (s, u, v PolyMatrix) {
+	s = a.Clone()
+	u = IdentityPolyMatrix(a.Rows())
+	v = IdentityPolyMatrix(a.Cols())
+	for i := range s {
+		for j := range s[i] {
+			s[i][j].sanitize(m)
+		}
+	}
+	n := s.Rows()
+	if s.Cols() < n {
+		n = s.Cols()
+	}
+	for t := 0; t < n; t++ {
+		for {
+			// move the entry of smallest degree to (t, t)
+			pi, pj := -1, -1
+			for i := t; i < s.Rows(); i++ {
+				for j := t; j < s.Cols(); j++ {
+					if !s[i][j].isZero() && (pi < 0 || s[i][j].GetDegree() < s[pi][pj].GetDegree()) {
+						pi, pj = i, j
+					}
+				}
+			}
+			if pi < 0 {
+				return
+			}
+			s.swapRows(t, pi)
+			u.swapRows(t, pi)
+			s.swapCols(t, pj)
+			v.swapCols(t, pj)
+
+			clean := true
+			for i := t + 1; i < s.Rows(); i++ {
+				if s[i][t].isZero() {
+					continue
+				}
+				q, r := s[i][t].Clone(0).Div(s[t][t].Clone(0), m)
+				q = q.Neg()
+				s.addRow(i, t, q, m)
+				u.addRow(i, t, q, m)
+				if !r.isZero() {
+					clean = false
+				}
+			}
+			for j := t + 1; j < s.Cols(); j++ {
+				if s[t][j].isZero() {
+					continue
+				}
+				q, r := s[t][j].Clone(0).Div(s[t][t].Clone(0), m)
+				q = q.Neg()
+				s.addCol(j, t, q, m)
+				v.addCol(j, t, q, m)
+				if !r.isZero() {
+					clean = false
+				}
+			}
+			if !clean {
+				continue
+			}
+			// the pivot must divide every remaining entry
+			bad := -1
+			for i := t + 1; i < s.Rows() && bad < 0; i++ {
+				for j := t + 1; j < s.Cols(); j++ {
+					if _, r := s[i][j].Clone(0).Div(s[t][t].Clone(0), m); !r.isZero() {
+						bad = i
+						break
+					}
+				}
+			}
+			if bad < 0 {
+				break
+			}
+			one := NewPolyInts(1)
+			s.addRow(t, bad, one, m)
+			u.addRow(t, bad, one, m)
+		}
+		inv := new(big.Int).ModInverse(s[t][t][s[t][t].GetDegree()], m)
+		s.scaleRow(t, inv, m)
+		u.scaleRow(t, inv, m)
+	}
+	return
+}
@@ -0,0 +1,123 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func mkPolyMatrix(es ...[]Poly) PolyMatrix {
+	return PolyMatrix(es)
+}
+
+func TestPolyMatrixArithmetic(t *testing.T) {
+	m := big.NewInt(7)
+	a := mkPolyMatrix(
+		[]Poly{NewPolyInts(0, 1), NewPolyInts(1)},
+		[]Poly{NewPolyInts(0), NewPolyInts(0, 1)},
+	)
+	b := IdentityPolyMatrix(2)
+	c, err := a.Mul(b, m)
+	if err != nil || !c.Equal(a) {
+		t.Errorf("%v * I = %v (error: %v)", a, c, err)
+	}
+	sq, _ := a.Mul(a, m)
+	ans := mkPolyMatrix(
+		[]Poly{NewPolyInts(0, 0, 1), NewPolyInts(0, 2)},
+		[]Poly{NewPolyInts(0), NewPolyInts(0, 0, 1)},
+	)
+	if !sq.Equal(ans) {
+		t.Errorf("%v^2 = %v, want %v", a, sq, ans)
+	}
+	sum, _ := a.Add(b, m)
+	if sum[0][0].Compare(&Poly{big.NewInt(1), big.NewInt(1)}) != 0 {
+		t.Errorf("%v + I = %v", a, sum)
+	}
+	if _, err := a.Mul(NewPolyMatrix(3, 1), m); err == nil {
+		t.Errorf("multiplying a 2x2 with a 3x1 matrix should fail")
+	}
+}
+
+func TestPolyMatrixDet(t *testing.T) {
+	m := big.NewInt(101)
+	cases := []struct {
+		a   PolyMatrix
+		ans Poly
+	}{
+		{
+			mkPolyMatrix(
+				[]Poly{NewPolyInts(0, 1), NewPolyInts(1)},
+				[]Poly{NewPolyInts(0), NewPolyInts(0, 1)},
+			),
+			NewPolyInts(0, 0, 1),
+		},
+		{
+			// the first pivot is zero
+			mkPolyMatrix(
+				[]Poly{NewPolyInts(0), NewPolyInts(1, 1)},
+				[]Poly{NewPolyInts(2), NewPolyInts(0, 1)},
+			),
+			NewPolyInts(99, 99),
+		},
+		{
+			// x*I - companion of x^3 - 2x - 5, whose determinant is the characteristic polynomial
+			mkPolyMatrix(
+				[]Poly{NewPolyInts(0, 1), NewPolyInts(0), NewPolyInts(96)},
+				[]Poly{NewPolyInts(100), NewPolyInts(0, 1), NewPolyInts(99)},
+				[]Poly{NewPolyInts(0), NewPolyInts(100), NewPolyInts(0, 1)},
+			),
+			NewPolyInts(96, 99, 0, 1),
+		},
+	}
+	for _, c := range cases {
+		d, err := c.a.Det(m)
+		if err != nil || d.Compare(&c.ans) != 0 {
+			t.Errorf("det %v = %v, want %v (error: %v)", c.a, d, c.ans, err)
+		}
+	}
+}
+
+func TestPolyMatrixNormalForms(t *testing.T) {
+	m := big.NewInt(7)
+	a := mkPolyMatrix(
+		[]Poly{NewPolyInts(0, 1), NewPolyInts(1), NewPolyInts(2, 1)},
+		[]Poly{NewPolyInts(0), NewPolyInts(0, 1), NewPolyInts(1, 0, 1)},
+		[]Poly{NewPolyInts(0, 2), NewPolyInts(2), NewPolyInts(4, 2)},
+	)
+
+	h, u := a.HermiteForm(m)
+	if ua, _ := u.Mul(a, m); !ua.Equal(h) {
+		t.Errorf("U * A = %v, but H = %v", ua, h)
+	}
+	for i := 1; i < h.Rows(); i++ {
+		for j := 0; j < i && j < h.Cols(); j++ {
+			if !h[i][j].isZero() {
+				t.Errorf("H = %v is not upper triangular", h)
+			}
+		}
+	}
+
+	s, u, v := a.SmithForm(m)
+	ua, _ := u.Mul(a, m)
+	if uav, _ := ua.Mul(v, m); !uav.Equal(s) {
+		t.Errorf("U * A * V = %v, but S = %v", uav, s)
+	}
+	for i := range s {
+		for j := range s[i] {
+			if i != j && !s[i][j].isZero() {
+				t.Errorf("S = %v is not diagonal", s)
+			}
+		}
+	}
+	for i := 0; i+1 < s.Rows(); i++ {
+		if s[i+1][i+1].isZero() {
+			continue
+		}
+		if _, r := s[i+1][i+1].Clone(0).Div(s[i][i].Clone(0), m); s[i][i].isZero() || !r.isZero() {
+			t.Errorf("invariant factors of %v do not divide each other", s)
+		}
+	}
+	// the third row is twice the first one, so the rank is 2
+	if !s[2][2].isZero() || s[1][1].isZero() {
+		t.Errorf("S = %v should have rank 2", s)
+	}
+}
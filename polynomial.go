@@ -1,6 +1,7 @@
 package polynomial
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -66,6 +67,99 @@ func (p Poly) GetDegree() int {
 	return len(p) - 1
 }
 
+// Coeff() returns a copy of the coefficient of x^i
+// It returns 0 beyond the degree instead of panicking like p[i]
+func (p Poly) Coeff(i int) *big.Int {
+	if i < 0 || i >= len(p) || p[i] == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Set(p[i])
+}
+
+// SetCoeff() sets the coefficient of x^i to a copy of v
+// P grows when i is beyond the degree and is trimmed when the leading coefficient becomes zero
+func (p *Poly) SetCoeff(i int, v *big.Int) {
+	if i < 0 {
+		panic("polynomial: negative coefficient index")
+	}
+	for len(*p) <= i {
+		*p = append(*p, big.NewInt(0))
+	}
+	(*p)[i] = new(big.Int).Set(v)
+	p.trim()
+}
+
+// LeadingCoeff() returns a copy of the highest non-zero coefficient (0 for P = 0)
+func (p Poly) LeadingCoeff() *big.Int {
+	for i := len(p) - 1; i >= 0; i-- {
+		if p[i] != nil && p[i].Sign() != 0 {
+			return new(big.Int).Set(p[i])
+		}
+	}
+	return big.NewInt(0)
+}
+
+// ConstantTerm() returns a copy of P(0)
+func (p Poly) ConstantTerm() *big.Int {
+	return p.Coeff(0)
+}
+
+// IsConstant() checks if P has no term of positive degree
+func (p Poly) IsConstant() bool {
+	for i := 1; i < len(p); i++ {
+		if p[i] != nil && p[i].Sign() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMonic() checks if the leading coefficient is 1, or congruent to 1 modulo m if m is not nil
+// The leading coefficient is taken after reduction, so 12x^2 + x is monic modulo 11
+func (p Poly) IsMonic(m *big.Int) bool {
+	if m == nil {
+		return p.LeadingCoeff().Cmp(big.NewInt(1)) == 0
+	}
+	q := p.Clone(0)
+	q.sanitize(m)
+	return q.LeadingCoeff().Cmp(new(big.Int).Mod(big.NewInt(1), m)) == 0
+}
+
+var (
+	errZeroPoly      = errors.New("polynomial: the zero polynomial has no leading coefficient")
+	errNotInvertible = errors.New("polynomial: the leading coefficient is not invertible")
+)
+
+// Monic() returns P multiplied by the inverse of its leading coefficient modulo m
+// Without a modulus only a leading coefficient of 1 or -1 can be inverted
+func (p Poly) Monic(m *big.Int) (Poly, error) {
+	q := p.Clone(0)
+	q.sanitize(m)
+	q.trim()
+	lead := q.LeadingCoeff()
+	if lead.Sign() == 0 {
+		return nil, errZeroPoly
+	}
+	if m == nil {
+		if lead.CmpAbs(big.NewInt(1)) != 0 {
+			return nil, errNotInvertible
+		}
+		if lead.Sign() < 0 {
+			q = q.Neg()
+		}
+		return q, nil
+	}
+	inv := new(big.Int).ModInverse(lead, m)
+	if inv == nil {
+		return nil, errNotInvertible
+	}
+	for i := range q {
+		q[i].Mul(q[i], inv)
+		q[i].Mod(q[i], m)
+	}
+	return q, nil
+}
+
 // pretty print
 func (p Poly) String() (s string) {
 	s = "["
@@ -77,7 +171,7 @@ func (p Poly) String() (s string) {
 			} else {
 				s += " - "
 			}
-			if i == 0 || p[i].Int64() != -1 {
+			if i == 0 || !p[i].IsInt64() || p[i].Int64() != -1 {
 				s += p[i].String()[1:]
 			}
 		case 0:
@@ -86,7 +180,7 @@ func (p Poly) String() (s string) {
 			if i < len(p)-1 {
 				s += " + "
 			}
-			if i == 0 || p[i].Int64() != 1 {
+			if i == 0 || !p[i].IsInt64() || p[i].Int64() != 1 {
 				s += p[i].String()
 			}
 		}
@@ -108,6 +202,8 @@ func (p Poly) String() (s string) {
 // if P == Q, returns 0
 // if P > Q, returns 1
 // if P < Q, returns -1
+// The ordering is by degree first, then by the signed coefficients starting from the
+// constant term (not the leading one); see order.go for other orderings
 func (p *Poly) Compare(q *Poly) int {
 	switch {
 	case p.GetDegree() > q.GetDegree():
@@ -126,6 +222,38 @@ func (p *Poly) Compare(q *Poly) int {
 	return 0
 }
 
+// Equal() checks if P == Q
+// Untrimmed zero leading coefficients are ignored
+func (p Poly) Equal(q Poly) bool {
+	n := len(p)
+	if len(q) > n {
+		n = len(q)
+	}
+	for i := 0; i < n; i++ {
+		var a, b *big.Int
+		if i < len(p) {
+			a = p[i]
+		}
+		if i < len(q) {
+			b = q[i]
+		}
+		switch {
+		case a == nil && b == nil:
+		case a == nil:
+			if b.Sign() != 0 {
+				return false
+			}
+		case b == nil:
+			if a.Sign() != 0 {
+				return false
+			}
+		case a.Cmp(b) != 0:
+			return false
+		}
+	}
+	return true
+}
+
 // Add() adds two polynomials
 // modulo m can be nil
 func (p Poly) Add(q Poly, m *big.Int) Poly {
@@ -202,12 +330,44 @@ func (p Poly) Sub(q Poly, m *big.Int) Poly {
 }
 
 // P * Q
+// Operands of at least GetTuning().KaratsubaThreshold coefficients use Karatsuba's algorithm
 func (p Poly) Mul(q Poly, m *big.Int) Poly {
+	if h := metricsHook(); h != nil {
+		defer h.record("Mul", mulCost(len(p), len(q), m, GetTuning()), time.Now(), heapObjects())
+	}
+	return p.mul(q, m)
+}
+
+func (p Poly) mul(q Poly, m *big.Int) Poly {
 	if m != nil {
 		p.sanitize(m)
 		q.sanitize(m)
 	}
-	var r Poly = make([]*big.Int, p.GetDegree()+q.GetDegree()+1)
+	var r Poly
+	t := GetTuning()
+	if useNTT(len(p), len(q), m, t) {
+		if n, w := nttPlan(len(p), len(q), m); w != nil {
+			r = mulNTT(p, q, n, w, m)
+		}
+	}
+	switch {
+	case r != nil:
+	case t.KaratsubaThreshold > 0 && len(p) >= t.KaratsubaThreshold && len(q) >= t.KaratsubaThreshold:
+		r = karatsuba(p, q, m, t)
+	default:
+		r = mulSchoolbook(p, q, m, t.EagerReduction)
+	}
+	r.trim()
+	return r
+}
+
+// mulSchoolbook() returns the untrimmed product of two coefficient slices in O(len(p) len(q))
+// Modulo m the products are accumulated per coefficient and reduced once (see mulDeferred()) unless eager is set
+func mulSchoolbook(p, q []*big.Int, m *big.Int, eager bool) Poly {
+	if m != nil && !eager {
+		return mulDeferred(p, q, m)
+	}
+	var r Poly = make([]*big.Int, len(p)+len(q)-1)
 	for i := 0; i < len(r); i++ {
 		r[i] = big.NewInt(0)
 	}
@@ -222,12 +382,18 @@ func (p Poly) Mul(q Poly, m *big.Int) Poly {
 			r[i+j] = a
 		}
 	}
-	r.trim()
 	return r
 }
 
 // returns (P / Q, P % Q)
 func (p Poly) Div(q Poly, m *big.Int) (quo, rem Poly) {
+	if h := metricsHook(); h != nil {
+		defer h.record("Div", divCost(len(p), len(q), m), time.Now(), heapObjects())
+	}
+	return p.div(q, m)
+}
+
+func (p Poly) div(q Poly, m *big.Int) (quo, rem Poly) {
 	if m != nil {
 		p.sanitize(m)
 		q.sanitize(m)
@@ -290,13 +456,23 @@ func (p Poly) Gcd(q Poly, m *big.Int) Poly {
 	if q.isZero() {
 		return p
 	} else {
-		_, rem := p.Div(q, m)
+		rem := p.Rem(q, m)
 		return q.Gcd(rem, m)
 	}
 }
 
 // Eval() returns p(v) where v is the given big integer
 func (p Poly) Eval(x *big.Int, m *big.Int) (y *big.Int) {
+	if h := metricsHook(); h != nil {
+		defer h.record("Eval", evalCost(len(p), m, GetTuning().EagerReduction), time.Now(), heapObjects())
+	}
+	return p.eval(x, m)
+}
+
+func (p Poly) eval(x *big.Int, m *big.Int) (y *big.Int) {
+	if m != nil && !GetTuning().EagerReduction {
+		return p.evalDeferred(x, m)
+	}
 	y = big.NewInt(0)
 	accx := big.NewInt(1)
 	xd := new(big.Int)
@@ -191,7 +191,7 @@ func TestAdd(t *testing.T) {
 	}
 }
 
-func ExampleRandPoly() {
+func ExampleRandomPoly() {
 	p := RandomPoly(10, 128) // 계수의 크기가 0~2^128인 임의의 10차 다항식 생성
 	fmt.Println(p)
 }
@@ -627,3 +627,111 @@ func TestEval(t *testing.T) {
 		}
 	}
 }
+
+func TestCoeff(t *testing.T) {
+	p := NewPolyInts(5, -4, 3)
+	cases := []struct {
+		i   int
+		ans int64
+	}{
+		{0, 5}, {1, -4}, {2, 3}, {3, 0}, {100, 0}, {-1, 0},
+	}
+	for _, c := range cases {
+		if res := p.Coeff(c.i); res.Int64() != c.ans {
+			t.Errorf("coefficient %v of %v should be %v (your answer was %v)", c.i, p, c.ans, res)
+		}
+	}
+	p.Coeff(0).SetInt64(100)
+	if p[0].Int64() != 5 {
+		t.Errorf("Coeff() should return a copy")
+	}
+}
+
+func TestSetCoeff(t *testing.T) {
+	cases := []struct {
+		p   Poly
+		i   int
+		v   int64
+		ans Poly
+	}{
+		{NewPolyInts(1, 2), 4, 7, NewPolyInts(1, 2, 0, 0, 7)},
+		{NewPolyInts(1, 2, 3), 2, 0, NewPolyInts(1, 2)},
+		{NewPolyInts(1, 2, 3), 0, -9, NewPolyInts(-9, 2, 3)},
+		{NewPolyInts(0), 3, 0, NewPolyInts(0)},
+	}
+	for _, c := range cases {
+		tmp := c.p.Clone(0)
+		v := big.NewInt(c.v)
+		c.p.SetCoeff(c.i, v)
+		if c.p.Compare(&c.ans) != 0 {
+			t.Errorf("setting coefficient %v of %v to %v gives %v, want %v", c.i, tmp, c.v, c.p, c.ans)
+		}
+		v.SetInt64(12345)
+		if c.i <= c.p.GetDegree() && c.p[c.i].Int64() == 12345 {
+			t.Errorf("SetCoeff() should copy the value")
+		}
+	}
+}
+
+func TestInspectionHelpers(t *testing.T) {
+	m := big.NewInt(11)
+	cases := []struct {
+		p          Poly
+		lead, cons int64
+		constant   bool
+		monic      bool
+		monicMod   bool
+	}{
+		{NewPolyInts(0), 0, 0, true, false, false},
+		{NewPolyInts(7), 7, 7, true, false, false},
+		{NewPolyInts(1), 1, 1, true, true, true},
+		{NewPolyInts(3, 2, 1), 1, 3, false, true, true},
+		{NewPolyInts(0, 1, 12), 12, 0, false, false, true},
+		{NewPolyInts(0, 1, 11), 11, 0, false, false, true},
+		{NewPolyInts(-5, 0, -10), -10, -5, false, false, true},
+	}
+	for _, c := range cases {
+		if v := c.p.LeadingCoeff(); v.Int64() != c.lead {
+			t.Errorf("leading coefficient of %v should be %v (your answer was %v)", c.p, c.lead, v)
+		}
+		if v := c.p.ConstantTerm(); v.Int64() != c.cons {
+			t.Errorf("constant term of %v should be %v (your answer was %v)", c.p, c.cons, v)
+		}
+		if c.p.IsConstant() != c.constant {
+			t.Errorf("IsConstant(%v) should be %v", c.p, c.constant)
+		}
+		if c.p.IsMonic(nil) != c.monic {
+			t.Errorf("IsMonic(%v) should be %v", c.p, c.monic)
+		}
+		if c.p.IsMonic(m) != c.monicMod {
+			t.Errorf("IsMonic(%v) modulo %v should be %v", c.p, m, c.monicMod)
+		}
+	}
+}
+
+func TestMonic(t *testing.T) {
+	cases := []struct {
+		p   Poly
+		m   *big.Int
+		ans Poly
+		ok  bool
+	}{
+		{NewPolyInts(1, 2, 3), big.NewInt(11), NewPolyInts(4, 8, 1), true},
+		{NewPolyInts(5, 0, -1), big.NewInt(11), NewPolyInts(6, 0, 1), true},
+		{NewPolyInts(1, 2, 12), big.NewInt(11), NewPolyInts(1, 2, 1), true},
+		{NewPolyInts(3, -1), nil, NewPolyInts(-3, 1), true},
+		{NewPolyInts(3, 2), nil, nil, false},
+		{NewPolyInts(1, 3), big.NewInt(9), nil, false},
+		{NewPolyInts(0), big.NewInt(11), nil, false},
+	}
+	for _, c := range cases {
+		res, err := c.p.Monic(c.m)
+		if (err == nil) != c.ok {
+			t.Errorf("Monic(%v) modulo %v: unexpected error %v", c.p, c.m, err)
+			continue
+		}
+		if c.ok && res.Compare(&c.ans) != 0 {
+			t.Errorf("Monic(%v) modulo %v != %v (your answer was %v)", c.p, c.m, c.ans, res)
+		}
+	}
+}
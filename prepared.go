@@ -0,0 +1,55 @@
+package polynomial
+
+import "math/big"
+
+// PreparedMultiplier multiplies many polynomials by one fixed polynomial F modulo a prime m
+// It keeps the NTT of F, so every product costs two transforms instead of three
+type PreparedMultiplier struct {
+	f      Poly
+	maxDeg int
+	w, m   *big.Int
+	fhat   []*big.Int
+}
+
+// NewPreparedMultiplier() prepares F for products with polynomials of degree up to maxDeg
+// The transform size is the smallest power of two n > deg F + maxDeg, and n must divide m - 1
+func NewPreparedMultiplier(f Poly, maxDeg int, m *big.Int) (*PreparedMultiplier, error) {
+	f = f.Clone(0)
+	f.sanitize(m)
+	n := 1
+	for n <= f.GetDegree()+maxDeg {
+		n <<= 1
+	}
+	w, err := nttRoot(n, m)
+	if err != nil {
+		return nil, err
+	}
+	fhat := make([]*big.Int, n)
+	for i := range fhat {
+		fhat[i] = f.Coeff(i)
+	}
+	ntt(fhat, w, m)
+	return &PreparedMultiplier{f, maxDeg, w, m, fhat}, nil
+}
+
+// Mul() returns F * G modulo m
+// G of degree above maxDeg falls back to Poly.Mul()
+func (pm *PreparedMultiplier) Mul(g Poly) Poly {
+	g = g.Clone(0)
+	g.sanitize(pm.m)
+	if g.GetDegree() > pm.maxDeg {
+		return pm.f.Clone(0).Mul(g, pm.m)
+	}
+	ghat := make([]*big.Int, len(pm.fhat))
+	for i := range ghat {
+		ghat[i] = g.Coeff(i)
+	}
+	ntt(ghat, pm.w, pm.m)
+	for i, c := range ghat {
+		c.Mul(c, pm.fhat[i]).Mod(c, pm.m)
+	}
+	inverseNTT(ghat, pm.w, pm.m)
+	r := Poly(ghat)
+	r.trim()
+	return r
+}
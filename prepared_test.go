@@ -0,0 +1,29 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestPreparedMultiplier(t *testing.T) {
+	m := big.NewInt(998244353)
+	f := RandomPoly(50, 40)
+	pm, err := NewPreparedMultiplier(f, 80, m)
+	if err != nil {
+		t.Fatalf("NewPreparedMultiplier() failed: %v", err)
+	}
+	for _, d := range []int64{0, 1, 30, 80, 200} {
+		g := RandomPoly(d, 40)
+		want := f.Clone(0).Mul(g.Clone(0), m)
+		if got := pm.Mul(g); got.Compare(&want) != 0 {
+			t.Errorf("PreparedMultiplier.Mul() of degree %d differs from Mul()", d)
+		}
+	}
+	if got := pm.Mul(NewPolyInts(0)); !got.isZero() {
+		t.Errorf("F * 0 = %v", got)
+	}
+
+	if _, err := NewPreparedMultiplier(f, 80, big.NewInt(1000000007)); err == nil {
+		t.Errorf("1000000007 - 1 = 2 * 500000003 has no root of unity of order 256")
+	}
+}
@@ -0,0 +1,206 @@
+package polynomial
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+var errProto = errors.New("polynomial: malformed protobuf message")
+
+// protobuf wire types
+const (
+	protoVarint = 0
+	proto64     = 1
+	protoBytes  = 2
+	proto32     = 5
+)
+
+// appendTag() appends the key of field num
+func appendTag(b []byte, num, wire int) []byte {
+	return binary.AppendUvarint(b, uint64(num)<<3|uint64(wire))
+}
+
+// appendBytes() appends a length-delimited field
+func appendBytes(b []byte, num int, v []byte) []byte {
+	b = appendTag(b, num, protoBytes)
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
+
+// appendVarint() appends a varint field, omitted when zero as in proto3
+func appendVarint(b []byte, num int, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	return binary.AppendUvarint(appendTag(b, num, protoVarint), v)
+}
+
+// parseProto() calls f for every field of a message; data holds the payload of length-delimited fields and v the
+// value of varints; fixed-size fields are skipped
+func parseProto(b []byte, f func(num, wire int, v uint64, data []byte) error) error {
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 || key>>3 == 0 || key>>3 > 1<<29 {
+			return errProto
+		}
+		b = b[n:]
+		num, wire := int(key>>3), int(key&7)
+		var v uint64
+		var data []byte
+		switch wire {
+		case protoVarint:
+			if v, n = binary.Uvarint(b); n <= 0 {
+				return errProto
+			}
+			b = b[n:]
+		case protoBytes:
+			l, n := binary.Uvarint(b)
+			if n <= 0 || l > uint64(len(b)-n) {
+				return errProto
+			}
+			data, b = b[n:n+int(l)], b[n+int(l):]
+		case proto64, proto32:
+			size := 8
+			if wire == proto32 {
+				size = 4
+			}
+			if len(b) < size {
+				return errProto
+			}
+			b = b[size:]
+			continue
+		default:
+			return errProto
+		}
+		if err := f(num, wire, v, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// integerToProto() encodes an Integer message
+func integerToProto(x *big.Int) []byte {
+	var b []byte
+	if x.Sign() != 0 {
+		b = appendBytes(b, 1, x.Bytes())
+	}
+	if x.Sign() < 0 {
+		b = appendVarint(b, 2, 1)
+	}
+	return b
+}
+
+// integerFromProto() decodes an Integer message
+func integerFromProto(b []byte) (*big.Int, error) {
+	x := new(big.Int)
+	neg := false
+	err := parseProto(b, func(num, wire int, v uint64, data []byte) error {
+		switch {
+		case num == 1 && wire == protoBytes:
+			x.SetBytes(data)
+		case num == 2 && wire == protoVarint:
+			neg = v != 0
+		}
+		return nil
+	})
+	if neg {
+		x.Neg(x)
+	}
+	return x, err
+}
+
+// ToProto() encodes P as a Poly message of polynomial.proto
+func (p Poly) ToProto() []byte {
+	var b []byte
+	for _, c := range p {
+		b = appendBytes(b, 1, integerToProto(c))
+	}
+	return b
+}
+
+// PolyFromProto() decodes a Poly message; an empty message is P = 0
+func PolyFromProto(b []byte) (Poly, error) {
+	var p Poly
+	err := parseProto(b, func(num, wire int, _ uint64, data []byte) error {
+		if num != 1 || wire != protoBytes {
+			return nil
+		}
+		c, err := integerFromProto(data)
+		p = append(p, c)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(p) == 0 {
+		return NewPolyInts(0), nil
+	}
+	p.trim()
+	return p, nil
+}
+
+// ToProto() encodes the point as a Point message
+func (p Point) ToProto() []byte {
+	return appendBytes(appendBytes(nil, 1, integerToProto(p.x)), 2, integerToProto(p.y))
+}
+
+// PointFromProto() decodes a Point message; missing coordinates are 0
+func PointFromProto(b []byte) (Point, error) {
+	p := Point{new(big.Int), new(big.Int)}
+	err := parseProto(b, func(num, wire int, _ uint64, data []byte) error {
+		var err error
+		switch {
+		case num == 1 && wire == protoBytes:
+			p.x, err = integerFromProto(data)
+		case num == 2 && wire == protoBytes:
+			p.y, err = integerFromProto(data)
+		}
+		return err
+	})
+	return p, err
+}
+
+// ShareBundle is a set of shares of a Threshold-out-of-len(Shares) sharing modulo Modulus
+type ShareBundle struct {
+	Modulus   *big.Int
+	Threshold int
+	Shares    Points
+}
+
+// ToProto() encodes the bundle as a ShareBundle message
+func (s ShareBundle) ToProto() []byte {
+	var b []byte
+	if s.Modulus != nil {
+		b = appendBytes(b, 1, integerToProto(s.Modulus))
+	}
+	b = appendVarint(b, 2, uint64(s.Threshold))
+	for _, p := range s.Shares {
+		b = appendBytes(b, 3, p.ToProto())
+	}
+	return b
+}
+
+// ShareBundleFromProto() decodes a ShareBundle message
+func ShareBundleFromProto(b []byte) (ShareBundle, error) {
+	s := ShareBundle{Modulus: new(big.Int)}
+	err := parseProto(b, func(num, wire int, v uint64, data []byte) error {
+		var err error
+		switch {
+		case num == 1 && wire == protoBytes:
+			s.Modulus, err = integerFromProto(data)
+		case num == 2 && wire == protoVarint:
+			if v > 1<<31-1 {
+				return errProto
+			}
+			s.Threshold = int(v)
+		case num == 3 && wire == protoBytes:
+			var p Point
+			p, err = PointFromProto(data)
+			s.Shares = append(s.Shares, p)
+		}
+		return err
+	})
+	return s, err
+}
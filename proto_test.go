@@ -0,0 +1,73 @@
+package polynomial
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+func TestPolyProto(t *testing.T) {
+	cases := []struct {
+		p    Poly
+		wire []byte
+	}{
+		{NewPolyInts(1, -2), []byte{0x0a, 0x03, 0x0a, 0x01, 0x01, 0x0a, 0x05, 0x0a, 0x01, 0x02, 0x10, 0x01}},
+		// a zero coefficient is an empty Integer
+		{NewPolyInts(0, 300), []byte{0x0a, 0x00, 0x0a, 0x04, 0x0a, 0x02, 0x01, 0x2c}},
+		{NewPolyInts(0), []byte{0x0a, 0x00}},
+	}
+	for _, c := range cases {
+		if got := c.p.ToProto(); !bytes.Equal(got, c.wire) {
+			t.Errorf("%v.ToProto() = %x, expected %x", c.p, got, c.wire)
+		}
+		if got, err := PolyFromProto(c.wire); err != nil || !got.Equal(c.p) {
+			t.Errorf("PolyFromProto(%x) = %v, %v, expected %v", c.wire, got, err, c.p)
+		}
+	}
+	p := RandomPoly(20, 300)
+	if got, err := PolyFromProto(p.ToProto()); err != nil || !got.Equal(p) {
+		t.Errorf("round trip of %v = %v, %v", p, got, err)
+	}
+	// unknown fields of every wire type are skipped
+	extra := append([]byte{0x10, 0x96, 0x01, 0x19, 1, 2, 3, 4, 5, 6, 7, 8, 0x25, 1, 2, 3, 4, 0x22, 0x01, 0xff}, cases[0].wire...)
+	if got, err := PolyFromProto(extra); err != nil || !got.Equal(cases[0].p) {
+		t.Errorf("PolyFromProto() with unknown fields = %v, %v", got, err)
+	}
+	if got, err := PolyFromProto(nil); err != nil || !got.Equal(NewPolyInts(0)) {
+		t.Errorf("PolyFromProto(nil) = %v, %v", got, err)
+	}
+	for _, b := range [][]byte{{0x0a}, {0x0a, 0x05, 0x0a}, {0x0a, 0x02, 0x0a, 0x05}, {0x0b}, {0x00, 0x01}, {0x19, 1, 2}} {
+		if _, err := PolyFromProto(b); err != errProto {
+			t.Errorf("PolyFromProto(%x) error = %v", b, err)
+		}
+	}
+}
+
+func TestShareBundleProto(t *testing.T) {
+	q := big.NewInt(7919)
+	secret := NewPolyInts(1234, 56, 78)
+	var ps Points
+	for x := int64(1); x <= 4; x++ {
+		ps = append(ps, NewPoint(big.NewInt(x), secret.Eval(big.NewInt(x), q)))
+	}
+	s := ShareBundle{q, 3, ps}
+	got, err := ShareBundleFromProto(s.ToProto())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Modulus.Cmp(q) != 0 || got.Threshold != 3 || len(got.Shares) != len(ps) {
+		t.Fatalf("ShareBundleFromProto() = %+v", got)
+	}
+	for i, p := range got.Shares {
+		if p.X().Cmp(ps[i].X()) != 0 || p.Y().Cmp(ps[i].Y()) != 0 {
+			t.Errorf("share %d = %v, expected %v", i, p, ps[i])
+		}
+	}
+	if got := got.Shares[1:].Lagrange(q); !got.Equal(secret) {
+		t.Errorf("decoded shares recover %v, expected %v", got, secret)
+	}
+	pt := NewPoint(big.NewInt(-5), big.NewInt(0))
+	if got, err := PointFromProto(pt.ToProto()); err != nil || got.X().Int64() != -5 || got.Y().Sign() != 0 {
+		t.Errorf("PointFromProto() = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,51 @@
+package polynomial
+
+import "math/big"
+
+// RootPoly() encodes a set as the roots of (x - s_1)(x - s_2)...(x - s_n)
+func RootPoly(set []*big.Int, m *big.Int) Poly {
+	ps := make([]Poly, len(set))
+	for i, s := range set {
+		ps[i] = xMinusConst(s)
+	}
+	return product(ps, m)
+}
+
+// BlindEval() returns r * P(y) + y
+// If y is a root of P (a member of the encoded set), the result is y itself
+// otherwise it is a random-looking value as long as r is random and secret
+func (p Poly) BlindEval(y, r, m *big.Int) *big.Int {
+	v := p.Eval(y, m)
+	v.Mul(v, r)
+	v.Add(v, y)
+	if m != nil {
+		v.Mod(v, m)
+	}
+	return v
+}
+
+// BlindPoly() returns P * R, which keeps the roots of P and hides everything else
+// Summing blinded polynomials P*R + Q*S gives a polynomial whose roots are (w.h.p.) the intersection
+func (p Poly) BlindPoly(r Poly, m *big.Int) Poly {
+	return p.Clone(0).Mul(r.Clone(0), m)
+}
+
+// IntersectRoots() returns the monic GCD of two root-encoded sets, i.e. the encoding of their intersection
+func IntersectRoots(p, q Poly, m *big.Int) Poly {
+	g := p.Clone(0).Gcd(q.Clone(0), m)
+	if monic, err := g.Monic(m); err == nil {
+		return monic
+	}
+	return g
+}
+
+// FilterRoots() returns the candidates that are roots of P
+func (p Poly) FilterRoots(candidates []*big.Int, m *big.Int) []*big.Int {
+	var rs []*big.Int
+	for _, c := range candidates {
+		if p.Eval(c, m).Sign() == 0 {
+			rs = append(rs, c)
+		}
+	}
+	return rs
+}
@@ -0,0 +1,49 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func bigs(vs ...int64) []*big.Int {
+	r := make([]*big.Int, len(vs))
+	for i, v := range vs {
+		r[i] = big.NewInt(v)
+	}
+	return r
+}
+
+func TestRootPolyAndBlindEval(t *testing.T) {
+	m := big.NewInt(179424691)
+	p := RootPoly(bigs(3, 5, 7), m)
+	r := big.NewInt(987654321)
+	for _, y := range bigs(3, 5, 7) {
+		if v := p.BlindEval(y, r, m); v.Cmp(y) != 0 {
+			t.Errorf("BlindEval(%v) of a member should be %v (got %v)", y, y, v)
+		}
+	}
+	for _, y := range bigs(4, 6, 100) {
+		if v := p.BlindEval(y, r, m); v.Cmp(y) == 0 {
+			t.Errorf("BlindEval(%v) of a non-member should not be %v", y, y)
+		}
+	}
+}
+
+func TestIntersectRoots(t *testing.T) {
+	m := big.NewInt(179424691)
+	p := RootPoly(bigs(1, 2, 3, 4, 5), m)
+	q := RootPoly(bigs(4, 5, 6, 7), m)
+	g := IntersectRoots(p, q, m)
+	ans := RootPoly(bigs(4, 5), m)
+	if g.Compare(&ans) != 0 {
+		t.Errorf("IntersectRoots(%v, %v) = %v, want %v", p, q, g, ans)
+	}
+	// blinding keeps the common roots
+	bp := p.BlindPoly(NewPolyInts(17, 3, 11), m)
+	bq := q.BlindPoly(NewPolyInts(5, 19), m)
+	sum := bp.Add(bq, m)
+	roots := sum.FilterRoots(bigs(1, 2, 3, 4, 5, 6, 7), m)
+	if len(roots) != 2 || roots[0].Int64() != 4 || roots[1].Int64() != 5 {
+		t.Errorf("roots of the blinded sum should be [4 5] (got %v)", roots)
+	}
+}
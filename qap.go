@@ -0,0 +1,98 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+// R1CSEntry is a non-zero entry of an R1CS matrix
+// Row is the constraint index and Col is the variable index
+type R1CSEntry struct {
+	Row, Col int
+	Value    *big.Int
+}
+
+// R1CS is a rank-1 constraint system <A_j, w> * <B_j, w> = <C_j, w> given by sparse matrices
+type R1CS struct {
+	NumConstraints int
+	NumVariables   int
+	A, B, C        []R1CSEntry
+}
+
+// QAP is the quadratic arithmetic program of an R1CS
+// Constraint j is interpolated at Domain[j] and Z is the vanishing polynomial of the domain
+type QAP struct {
+	A, B, C []Poly
+	Z       Poly
+	Domain  []*big.Int
+	m       *big.Int
+}
+
+var (
+	errR1CSEntry   = errors.New("polynomial: R1CS entry is out of range")
+	errR1CSDomain  = errors.New("polynomial: the modulus is too small for the number of constraints")
+	errQAPWitness  = errors.New("polynomial: witness length does not match the number of variables")
+	errQAPNotExact = errors.New("polynomial: witness does not satisfy the QAP")
+)
+
+// combine() returns the polynomials sum_j M[j][i] * L_j(x) for every variable i
+func combine(entries []R1CSEntry, basis []Poly, nvars int, m *big.Int) ([]Poly, error) {
+	ps := make([]Poly, nvars)
+	for i := range ps {
+		ps[i] = NewPolyInts(0)
+	}
+	for _, e := range entries {
+		if e.Row < 0 || e.Row >= len(basis) || e.Col < 0 || e.Col >= nvars {
+			return nil, errR1CSEntry
+		}
+		ps[e.Col] = ps[e.Col].Add(basis[e.Row].Mul(Poly{new(big.Int).Set(e.Value)}, m), m)
+	}
+	return ps, nil
+}
+
+// ToQAP() interpolates every column of A, B and C over the domain {1, 2, ..., NumConstraints}
+// m must be a prime larger than the number of constraints
+func (r R1CS) ToQAP(m *big.Int) (*QAP, error) {
+	if m.Cmp(big.NewInt(int64(r.NumConstraints))) <= 0 {
+		return nil, errR1CSDomain
+	}
+	xs := make([]*big.Int, r.NumConstraints)
+	for j := range xs {
+		xs[j] = big.NewInt(int64(j + 1))
+	}
+	z, basis := lagrangeBasis(xs, m)
+	q := &QAP{Z: z, Domain: xs, m: m}
+	var err error
+	if q.A, err = combine(r.A, basis, r.NumVariables, m); err != nil {
+		return nil, err
+	}
+	if q.B, err = combine(r.B, basis, r.NumVariables, m); err != nil {
+		return nil, err
+	}
+	if q.C, err = combine(r.C, basis, r.NumVariables, m); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// Quotient() returns H(x) = (A(x) * B(x) - C(x)) / Z(x) where A(x) = sum w_i * A_i(x) (same for B, C)
+// It fails if the witness does not satisfy the constraints, i.e. Z(x) does not divide exactly
+func (q *QAP) Quotient(w []*big.Int) (Poly, error) {
+	if len(w) != len(q.A) {
+		return nil, errQAPWitness
+	}
+	sum := func(ps []Poly) Poly {
+		s := NewPolyInts(0)
+		for i, p := range ps {
+			s = s.Add(p.Mul(Poly{new(big.Int).Set(w[i])}, q.m), q.m)
+		}
+		return s
+	}
+	a, b, c := sum(q.A), sum(q.B), sum(q.C)
+	t := a.Mul(b, q.m).Sub(c, q.m)
+	h, rem := t.Div(q.Z.Clone(0), q.m)
+	if !rem.isZero() {
+		return nil, errQAPNotExact
+	}
+	return h, nil
+}
@@ -0,0 +1,71 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+// x^3 + x + 5 = out with variables [1, x, out, sym1, y, sym2]
+func mkTestR1CS() R1CS {
+	e := func(row, col int, v int64) R1CSEntry {
+		return R1CSEntry{row, col, big.NewInt(v)}
+	}
+	return R1CS{
+		NumConstraints: 4,
+		NumVariables:   6,
+		A:              []R1CSEntry{e(0, 1, 1), e(1, 3, 1), e(2, 1, 1), e(2, 4, 1), e(3, 0, 5), e(3, 5, 1)},
+		B:              []R1CSEntry{e(0, 1, 1), e(1, 1, 1), e(2, 0, 1), e(3, 0, 1)},
+		C:              []R1CSEntry{e(0, 3, 1), e(1, 4, 1), e(2, 5, 1), e(3, 2, 1)},
+	}
+}
+
+func TestR1CSToQAP(t *testing.T) {
+	m := big.NewInt(179424691)
+	r := mkTestR1CS()
+	q, err := r.ToQAP(m)
+	if err != nil {
+		t.Fatalf("ToQAP() failed: %v", err)
+	}
+	if q.Z.GetDegree() != 4 {
+		t.Errorf("Z(x) = %v should have degree 4", q.Z)
+	}
+	for _, x := range q.Domain {
+		if q.Z.Eval(x, m).Sign() != 0 {
+			t.Errorf("Z(%v) should be zero", x)
+		}
+	}
+	for name, mat := range map[string][]R1CSEntry{"A": r.A, "B": r.B, "C": r.C} {
+		ps := map[string][]Poly{"A": q.A, "B": q.B, "C": q.C}[name]
+		dense := make([][]int64, r.NumConstraints)
+		for j := range dense {
+			dense[j] = make([]int64, r.NumVariables)
+		}
+		for _, e := range mat {
+			dense[e.Row][e.Col] = e.Value.Int64()
+		}
+		for j, x := range q.Domain {
+			for i := 0; i < r.NumVariables; i++ {
+				if v := ps[i].Eval(x, m); v.Int64() != dense[j][i] {
+					t.Errorf("%v_%v(%v) = %v, want %v", name, i, x, v, dense[j][i])
+				}
+			}
+		}
+	}
+
+	w := []*big.Int{big.NewInt(1), big.NewInt(3), big.NewInt(35), big.NewInt(9), big.NewInt(27), big.NewInt(30)}
+	h, err := q.Quotient(w)
+	if err != nil {
+		t.Errorf("Quotient() rejects a valid witness: %v", err)
+	} else if h.GetDegree() > 2 {
+		t.Errorf("H(x) = %v should have degree at most 2", h)
+	}
+	w[2] = big.NewInt(36)
+	if _, err := q.Quotient(w); err == nil {
+		t.Errorf("Quotient() accepts an invalid witness")
+	}
+
+	r.A = append(r.A, R1CSEntry{4, 0, big.NewInt(1)})
+	if _, err := r.ToQAP(m); err == nil {
+		t.Errorf("ToQAP() accepts an out-of-range entry")
+	}
+}
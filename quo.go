@@ -0,0 +1,119 @@
+package polynomial
+
+import "math/big"
+
+// reversed() returns x^deg(P) P(1/x), the coefficients of P in reverse order
+func reversed(p Poly) Poly {
+	r := make(Poly, len(p))
+	for i, c := range p {
+		r[len(p)-1-i] = new(big.Int).Set(c)
+	}
+	return r
+}
+
+// divPrep() reduces P and Q modulo m in place and returns the inverse of the leading coefficient of Q
+// ok is false when Div() must handle the operands: without a modulus, or when Q is zero or not invertible
+func divPrep(p, q *Poly, m *big.Int) (inv *big.Int, ok bool) {
+	if m == nil {
+		return nil, false
+	}
+	p.sanitize(m)
+	q.sanitize(m)
+	p.trim()
+	q.trim()
+	if q.isZero() {
+		return nil, false
+	}
+	inv = new(big.Int).ModInverse((*q)[q.GetDegree()], m)
+	return inv, inv != nil
+}
+
+// useNewton() tells whether the quotient of P by Q is computed by Newton inversion
+func useNewton(p, q Poly) bool {
+	t := GetTuning().NewtonDivThreshold
+	return t > 0 && len(q) >= t && len(p)-len(q)+1 >= t
+}
+
+// newtonQuo() returns P / Q for deg P >= deg Q from rev(P / Q) = rev(P) rev(Q)^-1 mod x^(deg P - deg Q + 1)
+func newtonQuo(p, q Poly, m *big.Int) Poly {
+	n := p.GetDegree() - q.GetDegree() + 1
+	inv, _ := reversed(q).Trunc(n).InverseSeries(n, m)
+	rq := reversed(p).Trunc(n).Mul(inv, m).Trunc(n)
+	quo := make(Poly, n)
+	for i := range quo {
+		quo[i] = new(big.Int)
+		if j := n - 1 - i; j < len(rq) {
+			quo[i].Set(rq[j])
+		}
+	}
+	quo.trim()
+	return quo
+}
+
+// Quo() returns P / Q without building the remainder
+// Modulo m only the coefficients of degree >= deg Q are updated during long division, and large operands use
+// Newton inversion (see Tuning.NewtonDivThreshold); without a modulus it behaves like Div()
+func (p Poly) Quo(q Poly, m *big.Int) Poly {
+	inv, ok := divPrep(&p, &q, m)
+	if !ok {
+		quo, _ := p.Div(q, m)
+		return quo
+	}
+	if p.GetDegree() < q.GetDegree() {
+		return NewPolyInts(0)
+	}
+	if useNewton(p, q) {
+		return newtonQuo(p, q, m)
+	}
+	dq := q.GetDegree()
+	t := p[dq:].Clone(0)
+	quo := make(Poly, len(t))
+	c := new(big.Int)
+	for i := len(t) - 1; i >= 0; i-- {
+		quo[i] = new(big.Int).Mul(t[i], inv)
+		quo[i].Mod(quo[i], m)
+		// only t[i-dq..i-1] can still reach the quotient
+		for j := 1; j <= dq && i-j >= 0; j++ {
+			c.Mul(quo[i], q[dq-j])
+			t[i-j].Sub(t[i-j], c)
+			t[i-j].Mod(t[i-j], m)
+		}
+	}
+	quo.trim()
+	return quo
+}
+
+// Rem() returns P % Q without building the quotient
+// Modulo m the remainder is reduced in place, or obtained as P - (P / Q) Q from the Newton quotient for large
+// operands; without a modulus it behaves like Div()
+func (p Poly) Rem(q Poly, m *big.Int) Poly {
+	inv, ok := divPrep(&p, &q, m)
+	if !ok {
+		_, rem := p.Div(q, m)
+		return rem
+	}
+	if p.GetDegree() < q.GetDegree() {
+		return p.Clone(0)
+	}
+	dq := q.GetDegree()
+	if useNewton(p, q) {
+		return p.Trunc(dq).Sub(newtonQuo(p, q, m).Mul(q.Trunc(dq), m).Trunc(dq), m)
+	}
+	t := p.Clone(0)
+	c, d := new(big.Int), new(big.Int)
+	for i := len(t) - 1; i >= dq; i-- {
+		c.Mul(t[i], inv)
+		c.Mod(c, m)
+		for j := 0; j < dq; j++ {
+			d.Mul(c, q[j])
+			t[i-dq+j].Sub(t[i-dq+j], d)
+			t[i-dq+j].Mod(t[i-dq+j], m)
+		}
+	}
+	rem := t[:dq]
+	if dq == 0 {
+		rem = NewPolyInts(0)
+	}
+	rem.trim()
+	return rem
+}
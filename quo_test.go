@@ -0,0 +1,38 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestQuoRem(t *testing.T) {
+	m := big.NewInt(998244353)
+	cases := []struct {
+		p, q Poly
+		m    *big.Int
+	}{
+		{NewPolyInts(-4, 0, -2, 1), NewPolyInts(-3, 1), m},
+		{NewPolyInts(1, 2, 3), NewPolyInts(5), m},
+		{NewPolyInts(1, 2), NewPolyInts(1, 2, 3), m},
+		{NewPolyInts(0), NewPolyInts(1, 1), m},
+		{NewPolyInts(1, 2, 3, 4, 5), NewPolyInts(3, 0, 2), big.NewInt(7)},
+		{NewPolyInts(-4, 0, -2, 1), NewPolyInts(-3, 1), nil},
+		{NewPolyInts(1, 2, 3), NewPolyInts(0), m},
+		{RandomPoly(400, 64), RandomPoly(150, 64), m},
+		{RandomPoly(400, 64), RandomPoly(300, 64), m},
+	}
+	for _, tu := range []Tuning{DefaultTuning, {NewtonDivThreshold: 1}, {}} {
+		saved := GetTuning()
+		SetTuning(tu)
+		for _, c := range cases {
+			wantQuo, wantRem := c.p.Clone(0).Div(c.q.Clone(0), c.m)
+			if got := c.p.Clone(0).Quo(c.q.Clone(0), c.m); !got.Equal(wantQuo) {
+				t.Errorf("%+v: Quo() of degrees %d and %d = %v, expected %v", tu, c.p.GetDegree(), c.q.GetDegree(), got, wantQuo)
+			}
+			if got := c.p.Clone(0).Rem(c.q.Clone(0), c.m); !got.Equal(wantRem) {
+				t.Errorf("%+v: Rem() of degrees %d and %d = %v, expected %v", tu, c.p.GetDegree(), c.q.GetDegree(), got, wantRem)
+			}
+		}
+		SetTuning(saved)
+	}
+}
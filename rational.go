@@ -0,0 +1,153 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+// RationalFunc represents Num(x) / Den(x)
+// The fraction is kept reduced: gcd(Num, Den) = 1, and Den is monic modulo a prime or has a positive leading
+// coefficient over Z
+type RationalFunc struct {
+	Num, Den Poly
+}
+
+var (
+	errZeroDenominator = errors.New("polynomial: the denominator is zero")
+	errPole            = errors.New("polynomial: the point is a pole of the rational function")
+	errInexactValue    = errors.New("polynomial: the value is not an integer")
+)
+
+// NewRationalFunc() returns num / den, reduced when m is not nil
+func NewRationalFunc(num, den Poly, m *big.Int) (RationalFunc, error) {
+	if len(den) == 0 || den.Clone(0).isZeroMod(m) {
+		return RationalFunc{}, errZeroDenominator
+	}
+	r := RationalFunc{num.Clone(0), den.Clone(0)}
+	r.reduce(m)
+	return r, nil
+}
+
+// reduce() divides Num and Den by their GCD and makes Den monic
+// Without a modulus see reduceZ()
+func (r *RationalFunc) reduce(m *big.Int) {
+	if m == nil {
+		r.Num.trim()
+		r.Den.trim()
+		r.reduceZ()
+		return
+	}
+	r.Num.sanitize(m)
+	r.Den.sanitize(m)
+	if r.Num.isZero() {
+		r.Den = NewPolyInts(1)
+		return
+	}
+	g, err := r.Num.Clone(0).Gcd(r.Den.Clone(0), m).Monic(m)
+	if err == nil && g.GetDegree() > 0 {
+		r.Num, _ = r.Num.Div(g.Clone(0), m)
+		r.Den, _ = r.Den.Div(g, m)
+	}
+	lead := new(big.Int).ModInverse(r.Den[r.Den.GetDegree()], m)
+	if lead == nil {
+		return
+	}
+	r.Num = r.Num.Mul(Poly{lead}, m)
+	r.Den = r.Den.Mul(Poly{new(big.Int).Set(lead)}, m)
+}
+
+// reduceZ() divides Num and Den by their GCD over Z, where Div is not exact in general
+// The primitive part of the GCD over Q divides both with integer quotients (Gauss's lemma), and the GCD of the
+// contents is divided out separately, with its sign chosen to make the leading coefficient of Den positive
+func (r *RationalFunc) reduceZ() {
+	if r.Num.isZero() {
+		r.Den = NewPolyInts(1)
+		return
+	}
+	g, _ := NewRatPoly(r.Num).Gcd(NewRatPoly(r.Den)).ClearDenominators()
+	if g.GetDegree() > 0 {
+		rg := NewRatPoly(g.primitivePart())
+		num, _ := NewRatPoly(r.Num).Div(rg)
+		den, _ := NewRatPoly(r.Den).Div(rg)
+		r.Num, _ = num.ToPoly()
+		r.Den, _ = den.ToPoly()
+	}
+	c := new(big.Int).GCD(nil, nil, r.Num.content(), r.Den.content())
+	if r.Den[r.Den.GetDegree()].Sign() < 0 {
+		c.Neg(c)
+	}
+	for _, p := range []Poly{r.Num, r.Den} {
+		for _, a := range p {
+			a.Quo(a, c)
+		}
+	}
+}
+
+// Add() returns r + s
+func (r RationalFunc) Add(s RationalFunc, m *big.Int) RationalFunc {
+	num := r.Num.Clone(0).Mul(s.Den.Clone(0), m).Add(s.Num.Clone(0).Mul(r.Den.Clone(0), m), m)
+	t := RationalFunc{num, r.Den.Clone(0).Mul(s.Den.Clone(0), m)}
+	t.reduce(m)
+	return t
+}
+
+// Neg() returns -r
+func (r RationalFunc) Neg() RationalFunc {
+	return RationalFunc{r.Num.Neg(), r.Den.Clone(0)}
+}
+
+// Sub() returns r - s
+func (r RationalFunc) Sub(s RationalFunc, m *big.Int) RationalFunc {
+	return r.Add(s.Neg(), m)
+}
+
+// Mul() returns r * s
+func (r RationalFunc) Mul(s RationalFunc, m *big.Int) RationalFunc {
+	t := RationalFunc{r.Num.Clone(0).Mul(s.Num.Clone(0), m), r.Den.Clone(0).Mul(s.Den.Clone(0), m)}
+	t.reduce(m)
+	return t
+}
+
+// Div() returns r / s and fails when s is zero
+func (r RationalFunc) Div(s RationalFunc, m *big.Int) (RationalFunc, error) {
+	if s.Num.Clone(0).isZeroMod(m) {
+		return RationalFunc{}, errZeroDenominator
+	}
+	t := RationalFunc{r.Num.Clone(0).Mul(s.Den.Clone(0), m), r.Den.Clone(0).Mul(s.Num.Clone(0), m)}
+	t.reduce(m)
+	return t, nil
+}
+
+// isZeroMod() checks if P = 0 after reducing modulo m (which can be nil)
+func (p Poly) isZeroMod(m *big.Int) bool {
+	p.sanitize(m)
+	p.trim()
+	return p.isZero()
+}
+
+// Eval() returns r(x), or an error if x is a pole
+// Without a modulus the value must be an integer
+func (r RationalFunc) Eval(x, m *big.Int) (*big.Int, error) {
+	d := r.Den.Eval(x, m)
+	if d.Sign() == 0 {
+		return nil, errPole
+	}
+	n := r.Num.Eval(x, m)
+	if m != nil {
+		inv := new(big.Int).ModInverse(d, m)
+		if inv == nil {
+			return nil, errPole
+		}
+		n.Mul(n, inv)
+		return n.Mod(n, m), nil
+	}
+	q, rem := new(big.Int).QuoRem(n, d, new(big.Int))
+	if rem.Sign() != 0 {
+		return nil, errInexactValue
+	}
+	return q, nil
+}
+
+func (r RationalFunc) String() string {
+	return r.Num.String() + " / " + r.Den.String()
+}
@@ -0,0 +1,86 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRationalFuncReduce(t *testing.T) {
+	m := big.NewInt(101)
+	// (x^2 - 1) / (2x - 2) = (x + 1) / 2 = 51x + 51
+	r, err := NewRationalFunc(NewPolyInts(-1, 0, 1), NewPolyInts(-2, 2), m)
+	if err != nil {
+		t.Fatalf("NewRationalFunc() failed: %v", err)
+	}
+	num, den := NewPolyInts(51, 51), NewPolyInts(1)
+	if r.Num.Compare(&num) != 0 || r.Den.Compare(&den) != 0 {
+		t.Errorf("(x^2 - 1) / (2x - 2) is reduced to %v", r)
+	}
+	if _, err := NewRationalFunc(NewPolyInts(1), NewPolyInts(0), m); err == nil {
+		t.Errorf("a zero denominator should be rejected")
+	}
+	if _, err := NewRationalFunc(NewPolyInts(1), NewPolyInts(7), big.NewInt(7)); err != errZeroDenominator {
+		t.Errorf("a denominator vanishing modulo m should be rejected (error: %v)", err)
+	}
+
+	// over Z the common factor and the common content are divided out
+	cases := []struct {
+		num, den, wantNum, wantDen Poly
+	}{
+		{NewPolyInts(-1, 0, 1), NewPolyInts(-2, 2), NewPolyInts(1, 1), NewPolyInts(2)},
+		{NewPolyInts(0, 2), NewPolyInts(-4), NewPolyInts(0, -1), NewPolyInts(2)},
+		{NewPolyInts(2, 3, 1), NewPolyInts(-4, 2, 2), NewPolyInts(1, 1), NewPolyInts(-2, 2)},
+		{NewPolyInts(0), NewPolyInts(5, 1), NewPolyInts(0), NewPolyInts(1)},
+	}
+	for _, c := range cases {
+		r, err := NewRationalFunc(c.num, c.den, nil)
+		if err != nil || r.Num.Compare(&c.wantNum) != 0 || r.Den.Compare(&c.wantDen) != 0 {
+			t.Errorf("%v / %v is reduced over Z to %v (error: %v), want %v / %v", c.num, c.den, r, err, c.wantNum, c.wantDen)
+		}
+	}
+}
+
+func TestRationalFuncArithmetic(t *testing.T) {
+	m := big.NewInt(101)
+	a, _ := NewRationalFunc(NewPolyInts(1), NewPolyInts(-1, 1), m) // 1 / (x - 1)
+	b, _ := NewRationalFunc(NewPolyInts(1), NewPolyInts(1, 1), m)  // 1 / (x + 1)
+	// 1/(x-1) - 1/(x+1) = 2 / (x^2 - 1)
+	d := a.Sub(b, m)
+	num, den := NewPolyInts(2), NewPolyInts(100, 0, 1)
+	if d.Num.Compare(&num) != 0 || d.Den.Compare(&den) != 0 {
+		t.Errorf("%v - %v = %v", a, b, d)
+	}
+	// (1/(x-1)) / (1/(x+1)) = (x + 1) / (x - 1)
+	q, err := a.Div(b, m)
+	num, den = NewPolyInts(1, 1), NewPolyInts(100, 1)
+	if err != nil || q.Num.Compare(&num) != 0 || q.Den.Compare(&den) != 0 {
+		t.Errorf("%v / %v = %v (error: %v)", a, b, q, err)
+	}
+	// (x + 1)/(x - 1) * 1/(x + 1) = 1/(x - 1)
+	p := q.Mul(b, m)
+	if p.Num.Compare(&a.Num) != 0 || p.Den.Compare(&a.Den) != 0 {
+		t.Errorf("%v * %v = %v", q, b, p)
+	}
+	zero, _ := NewRationalFunc(NewPolyInts(0), NewPolyInts(1), m)
+	if _, err := a.Div(zero, m); err == nil {
+		t.Errorf("division by zero should fail")
+	}
+}
+
+func TestRationalFuncEval(t *testing.T) {
+	m := big.NewInt(101)
+	r, _ := NewRationalFunc(NewPolyInts(1, 1), NewPolyInts(-1, 1), m) // (x + 1) / (x - 1)
+	if v, err := r.Eval(big.NewInt(3), m); err != nil || v.Int64() != 2 {
+		t.Errorf("%v at 3 = %v, want 2 (error: %v)", r, v, err)
+	}
+	if _, err := r.Eval(big.NewInt(1), m); err == nil {
+		t.Errorf("1 is a pole of %v", r)
+	}
+	z, _ := NewRationalFunc(NewPolyInts(6, 5, 1), NewPolyInts(3), nil)
+	if v, err := z.Eval(big.NewInt(1), nil); err != nil || v.Int64() != 4 {
+		t.Errorf("%v at 1 = %v, want 4 (error: %v)", z, v, err)
+	}
+	if _, err := z.Eval(big.NewInt(2), nil); err != errInexactValue {
+		t.Errorf("%v at 2 is not an integer (error: %v)", z, err)
+	}
+}
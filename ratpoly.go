@@ -0,0 +1,234 @@
+package polynomial
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Data structure for a polynomial with rational coefficients
+// Same layout as Poly: f(x) = 3/2x^2 + 1 => [1 0 3/2]
+// Division is always exact, so Div and Gcd never fail like their Poly counterparts over Z
+type RatPoly []*big.Rat
+
+// NewRatPoly() converts a polynomial with integer coefficients
+func NewRatPoly(p Poly) RatPoly {
+	r := make(RatPoly, len(p))
+	for i, c := range p {
+		r[i] = new(big.Rat).SetInt(c)
+	}
+	r.trim()
+	return r
+}
+
+// NewRatPolyFracs() builds a polynomial from numerator/denominator pairs
+// NewRatPolyFracs(1, 2, 3, 1) = 3x + 1/2
+func NewRatPolyFracs(fracs ...int64) RatPoly {
+	r := make(RatPoly, len(fracs)/2)
+	for i := range r {
+		r[i] = big.NewRat(fracs[2*i], fracs[2*i+1])
+	}
+	r.trim()
+	return r
+}
+
+// trim() removes zero leading coefficients (but keeps the constant)
+func (r *RatPoly) trim() {
+	last := 0
+	for i := len(*r) - 1; i > 0; i-- {
+		if (*r)[i].Sign() != 0 {
+			last = i
+			break
+		}
+	}
+	if len(*r) == 0 {
+		*r = RatPoly{new(big.Rat)}
+		return
+	}
+	*r = (*r)[:last+1]
+}
+
+func (r RatPoly) isZero() bool {
+	return r.GetDegree() == 0 && r[0].Sign() == 0
+}
+
+// returns the degree
+func (r RatPoly) GetDegree() int {
+	return len(r) - 1
+}
+
+// ToPoly() returns the integer polynomial if every coefficient is an integer
+func (r RatPoly) ToPoly() (Poly, bool) {
+	p := make(Poly, len(r))
+	for i, c := range r {
+		if !c.IsInt() {
+			return nil, false
+		}
+		p[i] = new(big.Int).Set(c.Num())
+	}
+	return p, true
+}
+
+// pretty print, e.g. [3/2x^2 - x + 1]
+func (r RatPoly) String() (s string) {
+	s = "["
+	for i := len(r) - 1; i >= 0; i-- {
+		c := r[i]
+		if c.Sign() == 0 {
+			continue
+		}
+		switch {
+		case c.Sign() < 0 && i == len(r)-1:
+			s += "-"
+		case c.Sign() < 0:
+			s += " - "
+		case i < len(r)-1:
+			s += " + "
+		}
+		abs := new(big.Rat).Abs(c)
+		if i == 0 || abs.Cmp(big.NewRat(1, 1)) != 0 {
+			s += abs.RatString()
+		}
+		if i > 0 {
+			s += "x"
+			if i > 1 {
+				s += fmt.Sprintf("^%d", i)
+			}
+		}
+	}
+	if s == "[" {
+		s += "0"
+	}
+	return s + "]"
+}
+
+// Equal() checks if R == S
+func (r RatPoly) Equal(s RatPoly) bool {
+	if len(r) != len(s) {
+		return false
+	}
+	for i := range r {
+		if r[i].Cmp(s[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Add() adds two polynomials
+func (r RatPoly) Add(s RatPoly) RatPoly {
+	if len(r) < len(s) {
+		r, s = s, r
+	}
+	t := make(RatPoly, len(r))
+	for i := range r {
+		t[i] = new(big.Rat).Set(r[i])
+		if i < len(s) {
+			t[i].Add(t[i], s[i])
+		}
+	}
+	t.trim()
+	return t
+}
+
+// Neg() returns -R
+func (r RatPoly) Neg() RatPoly {
+	t := make(RatPoly, len(r))
+	for i, c := range r {
+		t[i] = new(big.Rat).Neg(c)
+	}
+	return t
+}
+
+// Sub() subtracts S from R
+func (r RatPoly) Sub(s RatPoly) RatPoly {
+	return r.Add(s.Neg())
+}
+
+// Mul() multiplies two polynomials
+func (r RatPoly) Mul(s RatPoly) RatPoly {
+	t := make(RatPoly, len(r)+len(s)-1)
+	for i := range t {
+		t[i] = new(big.Rat)
+	}
+	a := new(big.Rat)
+	for i := range r {
+		for j := range s {
+			t[i+j].Add(t[i+j], a.Mul(r[i], s[j]))
+		}
+	}
+	t.trim()
+	return t
+}
+
+// Div() returns (R / S, R % S)
+// Division by zero returns (0, R) like Poly.Div
+func (r RatPoly) Div(s RatPoly) (quo, rem RatPoly) {
+	rem = r.Add(RatPoly{new(big.Rat)})
+	if s.isZero() || r.GetDegree() < s.GetDegree() {
+		return RatPoly{new(big.Rat)}, rem
+	}
+	sd := s.GetDegree()
+	quo = make(RatPoly, r.GetDegree()-sd+1)
+	for i := range quo {
+		quo[i] = new(big.Rat)
+	}
+	lead := s[sd]
+	for !rem.isZero() && rem.GetDegree() >= sd {
+		k := rem.GetDegree() - sd
+		c := new(big.Rat).Quo(rem[rem.GetDegree()], lead)
+		quo[k] = c
+		for i := 0; i <= sd; i++ {
+			rem[i+k].Sub(rem[i+k], new(big.Rat).Mul(c, s[i]))
+		}
+		rem.trim()
+	}
+	quo.trim()
+	return
+}
+
+// Monic() divides R by its leading coefficient
+func (r RatPoly) Monic() RatPoly {
+	if r.isZero() {
+		return r.Add(RatPoly{new(big.Rat)})
+	}
+	lead := r[r.GetDegree()]
+	t := make(RatPoly, len(r))
+	for i, c := range r {
+		t[i] = new(big.Rat).Quo(c, lead)
+	}
+	return t
+}
+
+// Gcd() returns the monic greatest common divisor of R and S (Euclidean algorithm)
+func (r RatPoly) Gcd(s RatPoly) RatPoly {
+	for !s.isZero() {
+		_, rem := r.Div(s)
+		r, s = s, rem
+	}
+	return r.Monic()
+}
+
+// Eval() returns R(x)
+func (r RatPoly) Eval(x *big.Rat) *big.Rat {
+	y := new(big.Rat)
+	for i := len(r) - 1; i >= 0; i-- {
+		y.Mul(y, x)
+		y.Add(y, r[i])
+	}
+	return y
+}
+
+// ClearDenominators() returns (P, d) with R = P / d, where d is the least common multiple of the denominators
+func (r RatPoly) ClearDenominators() (Poly, *big.Int) {
+	d := big.NewInt(1)
+	g := new(big.Int)
+	for _, c := range r {
+		g.GCD(nil, nil, d, c.Denom())
+		d.Mul(d, new(big.Int).Quo(c.Denom(), g))
+	}
+	p := make(Poly, len(r))
+	for i, c := range r {
+		p[i] = new(big.Int).Quo(new(big.Int).Mul(c.Num(), d), c.Denom())
+	}
+	return p, d
+}
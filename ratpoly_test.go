@@ -0,0 +1,96 @@
+package polynomial
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestRatPolyPrint(t *testing.T) {
+	cases := []struct {
+		r   RatPoly
+		ans string
+	}{
+		{NewRatPolyFracs(0, 1), "[0]"},
+		{NewRatPolyFracs(1, 2, -1, 1, 3, 2), "[3/2x^2 - x + 1/2]"},
+		{NewRatPoly(NewPolyInts(5, -4, 3, 3)), "[3x^3 + 3x^2 - 4x + 5]"},
+	}
+	for _, c := range cases {
+		if s := fmt.Sprintf("%v", c.r); s != c.ans {
+			t.Errorf("Stringify %v should be %v", s, c.ans)
+		}
+	}
+}
+
+func TestRatPolyDivide(t *testing.T) {
+	cases := []struct {
+		r, s, quo, rem RatPoly
+	}{
+		{
+			// (x^2 + 1) / (2x) = x/2 rem 1, which Poly.Div cannot do over Z
+			NewRatPoly(NewPolyInts(1, 0, 1)),
+			NewRatPoly(NewPolyInts(0, 2)),
+			NewRatPolyFracs(0, 1, 1, 2),
+			NewRatPolyFracs(1, 1),
+		},
+		{
+			NewRatPoly(NewPolyInts(-1, 0, 0, 1)),
+			NewRatPoly(NewPolyInts(-1, 1)),
+			NewRatPoly(NewPolyInts(1, 1, 1)),
+			NewRatPolyFracs(0, 1),
+		},
+		{
+			NewRatPoly(NewPolyInts(3)),
+			NewRatPoly(NewPolyInts(1, 1)),
+			NewRatPolyFracs(0, 1),
+			NewRatPoly(NewPolyInts(3)),
+		},
+	}
+	for _, c := range cases {
+		quo, rem := c.r.Div(c.s)
+		if !quo.Equal(c.quo) || !rem.Equal(c.rem) {
+			t.Errorf("%v / %v = (%v, %v), want (%v, %v)", c.r, c.s, quo, rem, c.quo, c.rem)
+		}
+		back := quo.Mul(c.s).Add(rem)
+		if !back.Equal(c.r) {
+			t.Errorf("quo * s + rem = %v, want %v", back, c.r)
+		}
+	}
+}
+
+func TestRatPolyGcd(t *testing.T) {
+	// gcd(2x^2 - 2, 3x^2 + 3x) = x + 1
+	r := NewRatPoly(NewPolyInts(-2, 0, 2))
+	s := NewRatPoly(NewPolyInts(0, 3, 3))
+	g := r.Gcd(s)
+	if ans := NewRatPoly(NewPolyInts(1, 1)); !g.Equal(ans) {
+		t.Errorf("gcd(%v, %v) = %v, want %v", r, s, g, ans)
+	}
+	if v := r.Eval(big.NewRat(1, 2)); v.Cmp(big.NewRat(-3, 2)) != 0 {
+		t.Errorf("%v at 1/2 = %v, want -3/2", r, v)
+	}
+	if p, ok := r.ToPoly(); !ok || p.GetDegree() != 2 {
+		t.Errorf("ToPoly(%v) = %v, %v", r, p, ok)
+	}
+	if _, ok := NewRatPolyFracs(1, 2).ToPoly(); ok {
+		t.Errorf("1/2 is not an integer polynomial")
+	}
+}
+
+func TestRatPolyClearDenominators(t *testing.T) {
+	cases := []struct {
+		r   RatPoly
+		p   Poly
+		den int64
+	}{
+		{NewRatPolyFracs(1, 2, 3, 1), NewPolyInts(1, 6), 2},
+		{NewRatPolyFracs(1, 6, -1, 4, 2, 3), NewPolyInts(2, -3, 8), 12},
+		{NewRatPolyFracs(5, 1), NewPolyInts(5), 1},
+	}
+	for _, c := range cases {
+		p, den := c.r.ClearDenominators()
+		if p.Compare(&c.p) != 0 || den.Int64() != c.den {
+			t.Errorf("ClearDenominators(%v) = %v / %v, want %v / %d", c.r, p, den, c.p, c.den)
+		}
+	}
+}
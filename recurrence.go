@@ -0,0 +1,89 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	errShortSequence = errors.New("polynomial: the sequence is shorter than the recurrence")
+	errNegativeIndex = errors.New("polynomial: the sequence index must be non-negative")
+)
+
+// PowMod() returns P^e mod F
+// modulo m can be nil, in which case F should be monic
+func (p Poly) PowMod(e *big.Int, f Poly, m *big.Int) Poly {
+	_, base := p.Clone(0).Div(f.Clone(0), m)
+	r := NewPolyInts(1)
+	_, r = r.Div(f.Clone(0), m)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		_, r = r.Clone(0).Mul(r, m).Div(f.Clone(0), m)
+		if e.Bit(i) == 1 {
+			_, r = r.Mul(base.Clone(0), m).Div(f.Clone(0), m)
+		}
+	}
+	return r
+}
+
+// BerlekampMassey() returns the minimal characteristic polynomial of a sequence modulo a prime m
+// The result is x^L - c_1 x^(L-1) - ... - c_L where s_i = c_1 s_(i-1) + ... + c_L s_(i-L)
+func BerlekampMassey(seq []*big.Int, m *big.Int) Poly {
+	c, b := NewPolyInts(1), NewPolyInts(1)
+	l, shift := 0, 1
+	last := big.NewInt(1)
+	for i := range seq {
+		// discrepancy d = s_i + c_1 s_(i-1) + ... + c_l s_(i-l)
+		d := new(big.Int)
+		for j := 0; j <= l && j < len(c); j++ {
+			d.Add(d, new(big.Int).Mul(c[j], seq[i-j]))
+		}
+		d.Mod(d, m)
+		if d.Sign() == 0 {
+			shift++
+			continue
+		}
+		coef := new(big.Int).ModInverse(last, m)
+		coef.Mul(coef, d)
+		t := c.Clone(0)
+		c = c.Sub(b.Clone(shift).Mul(Poly{coef}, m), m)
+		if 2*l <= i {
+			l, b, last, shift = i+1-l, t, d, 1
+		} else {
+			shift++
+		}
+	}
+	// the characteristic polynomial is the reversal of the connection polynomial of length l
+	r := make(Poly, l+1)
+	for i := range r {
+		r[i] = new(big.Int).Set(c.Coeff(l - i))
+	}
+	return r
+}
+
+// RecurrenceTerm() returns s_n of the sequence defined by its first terms and its characteristic polynomial
+// It computes x^n mod charPoly = a_0 + a_1 x + ... and returns a_0 s_0 + a_1 s_1 + ... (Kitamasa)
+// modulo m can be nil, in which case charPoly should be monic
+func RecurrenceTerm(seq []*big.Int, charPoly Poly, n, m *big.Int) (*big.Int, error) {
+	if n.Sign() < 0 {
+		return nil, errNegativeIndex
+	}
+	if len(seq) < charPoly.GetDegree() {
+		return nil, errShortSequence
+	}
+	if n.Cmp(big.NewInt(int64(len(seq)))) < 0 {
+		s := new(big.Int).Set(seq[n.Int64()])
+		if m != nil {
+			s.Mod(s, m)
+		}
+		return s, nil
+	}
+	a := NewPolyInts(0, 1).PowMod(n, charPoly, m)
+	s := new(big.Int)
+	for i := 0; i <= a.GetDegree(); i++ {
+		s.Add(s, new(big.Int).Mul(a[i], seq[i]))
+	}
+	if m != nil {
+		s.Mod(s, m)
+	}
+	return s, nil
+}
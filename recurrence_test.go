@@ -0,0 +1,71 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBerlekampMassey(t *testing.T) {
+	m := big.NewInt(1000000007)
+	cases := []struct {
+		seq  []*big.Int
+		want Poly
+	}{
+		{bigs(0, 1, 1, 2, 3, 5, 8, 13), NewPolyInts(1000000006, 1000000006, 1)},
+		{bigs(1, 2, 4, 8, 16), NewPolyInts(1000000005, 1)},
+		{bigs(1, 0, 1, 0, 1, 0), NewPolyInts(1000000006, 0, 1)},
+		{bigs(0, 0, 0, 5, 0, 0, 0, 5), NewPolyInts(1000000006, 0, 0, 0, 1)},
+		{bigs(0, 0, 0), NewPolyInts(1)},
+	}
+	for _, c := range cases {
+		if got := BerlekampMassey(c.seq, m); got.Compare(&c.want) != 0 {
+			t.Errorf("BerlekampMassey(%v) = %v, want %v", c.seq, got, c.want)
+		}
+	}
+}
+
+func TestRecurrenceTerm(t *testing.T) {
+	m := big.NewInt(1000000007)
+	seq := bigs(0, 1, 1, 2, 3, 5)
+	c := BerlekampMassey(seq, m)
+	a, b := big.NewInt(0), big.NewInt(1)
+	for i := 0; i < 300; i++ {
+		a.Add(a, b)
+		a, b = b, a
+	}
+	want := new(big.Int).Mod(a, m)
+	got, err := RecurrenceTerm(seq, c, big.NewInt(300), m)
+	if err != nil || got.Cmp(want) != 0 {
+		t.Errorf("F_300 mod %v = %v, want %v (error: %v)", m, got, want, err)
+	}
+	if got, _ := RecurrenceTerm(seq, c, big.NewInt(4), m); got.Int64() != 3 {
+		t.Errorf("F_4 = %v, want 3", got)
+	}
+
+	// s_n = 2 s_(n-1) over Z, so s_n = 2^n
+	n := big.NewInt(200)
+	got, err = RecurrenceTerm(bigs(1), NewPolyInts(-2, 1), n, nil)
+	if want := new(big.Int).Lsh(big.NewInt(1), 200); err != nil || got.Cmp(want) != 0 {
+		t.Errorf("s_200 = %v, want %v (error: %v)", got, want, err)
+	}
+	if _, err := RecurrenceTerm(bigs(1), c, n, m); err == nil {
+		t.Errorf("a sequence shorter than the recurrence should be rejected")
+	}
+	if _, err := RecurrenceTerm(seq, c, big.NewInt(-1), m); err != errNegativeIndex {
+		t.Errorf("RecurrenceTerm() at -1: error %v, expected errNegativeIndex", err)
+	}
+}
+
+func TestPowMod(t *testing.T) {
+	m := big.NewInt(7)
+	// x^7 = x mod (x^7 - x) and by Fermat, (x + 1)^7 = x^7 + 1
+	f := NewPolyInts(0, 6, 0, 0, 0, 0, 0, 1)
+	got := NewPolyInts(1, 1).PowMod(big.NewInt(7), f, m)
+	if want := NewPolyInts(1, 1); got.Compare(&want) != 0 {
+		t.Errorf("(x + 1)^7 mod %v = %v, want %v", f, got, want)
+	}
+	got = NewPolyInts(3, 2).PowMod(big.NewInt(0), f, m)
+	if want := NewPolyInts(1); got.Compare(&want) != 0 {
+		t.Errorf("P^0 = %v, want 1", got)
+	}
+}
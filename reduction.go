@@ -0,0 +1,56 @@
+package polynomial
+
+import "math/big"
+
+// maxDeferred is the number of products summed before a reduction is forced
+// With operands reduced below m every product is below m^2, so an accumulator never exceeds
+// 2 bitlen(m) + 16 bits, about one machine word more than a single product
+const maxDeferred = 1 << 16
+
+// mulDeferred() returns the untrimmed product of two coefficient slices modulo m, computed coefficient by
+// coefficient: the column sum p_i q_(k-i) is accumulated unreduced and reduced once per maxDeferred products
+func mulDeferred(p, q []*big.Int, m *big.Int) Poly {
+	r := make(Poly, len(p)+len(q)-1)
+	t := new(big.Int)
+	for k := range r {
+		acc := new(big.Int)
+		lo, hi := k-len(q)+1, k
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > len(p)-1 {
+			hi = len(p) - 1
+		}
+		for i, n := lo, 0; i <= hi; i++ {
+			acc.Add(acc, t.Mul(p[i], q[k-i]))
+			if n++; n == maxDeferred {
+				acc.Mod(acc, m)
+				n = 0
+			}
+		}
+		r[k] = acc.Mod(acc, m)
+	}
+	return r
+}
+
+// evalDeferred() is eval() summing the terms p_i x^i unreduced; only the power of x is reduced at every step
+func (p Poly) evalDeferred(x, m *big.Int) *big.Int {
+	y := new(big.Int)
+	accx := big.NewInt(1)
+	t := new(big.Int)
+	for i, n := 0, 0; i <= p.GetDegree(); i++ {
+		y.Add(y, t.Mul(accx, p[i]))
+		if n++; n == maxDeferred {
+			y.Mod(y, m)
+			n = 0
+		}
+		accx.Mul(accx, x)
+		accx.Mod(accx, m)
+	}
+	return y.Mod(y, m)
+}
+
+// deferredMods() counts the reductions of a sum of n products: one per full batch and a final one
+func deferredMods(n int) int64 {
+	return int64(n/maxDeferred + 1)
+}
@@ -0,0 +1,56 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDeferredReduction(t *testing.T) {
+	saved := GetTuning()
+	defer SetTuning(saved)
+	m := big.NewInt(998244353)
+	big1 := new(big.Int).Lsh(big.NewInt(1), 200)
+	big1.Sub(big1, big.NewInt(1))
+	cases := []struct {
+		p, q Poly
+		m    *big.Int
+	}{
+		{NewPolyInts(1, 2, 3), NewPolyInts(4, 5), m},
+		{NewPolyInts(-1, 2, -3), NewPolyInts(4, -5), big.NewInt(7)},
+		{RandomPoly(60, 64), RandomPoly(45, 64), m},
+		{RandomPoly(20, 256), RandomPoly(30, 256), big1},
+	}
+	x := big.NewInt(-123456)
+	for _, c := range cases {
+		SetTuning(Tuning{EagerReduction: true})
+		wantMul := c.p.Clone(0).Mul(c.q.Clone(0), c.m)
+		wantEval := c.p.Clone(0).Eval(x, c.m)
+		for _, tu := range []Tuning{{}, {KaratsubaThreshold: 4}} {
+			SetTuning(tu)
+			if got := c.p.Clone(0).Mul(c.q.Clone(0), c.m); !got.Equal(wantMul) {
+				t.Errorf("%+v: deferred Mul() of degrees %d and %d differs from the eager product", tu, c.p.GetDegree(), c.q.GetDegree())
+			}
+			if got := c.p.Clone(0).Eval(x, c.m); got.Cmp(wantEval) != 0 {
+				t.Errorf("%+v: deferred Eval() = %v, expected %v", tu, got, wantEval)
+			}
+		}
+	}
+}
+
+func TestDeferredMods(t *testing.T) {
+	cases := []struct {
+		n    int
+		want int64
+	}{
+		{0, 1},
+		{1, 1},
+		{maxDeferred - 1, 1},
+		{maxDeferred, 2},
+		{2*maxDeferred + 1, 3},
+	}
+	for _, c := range cases {
+		if got := deferredMods(c.n); got != c.want {
+			t.Errorf("deferredMods(%d) = %d, expected %d", c.n, got, c.want)
+		}
+	}
+}
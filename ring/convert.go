@@ -0,0 +1,26 @@
+package ring
+
+import (
+	"math/big"
+
+	"github.com/jongukim/polynomial"
+)
+
+// FromPoly() converts a polynomial.Poly to a polynomial over BigMod{m}
+func FromPoly(p polynomial.Poly, m *big.Int) Poly[*big.Int] {
+	r := BigMod{m}
+	c := make([]*big.Int, len(p))
+	for i, a := range p {
+		c[i] = new(big.Int).Mod(a, m)
+	}
+	return New[*big.Int](r, c...)
+}
+
+// ToPoly() converts a polynomial with big integer coefficients back to a polynomial.Poly
+func ToPoly(p Poly[*big.Int]) polynomial.Poly {
+	q := make(polynomial.Poly, len(p.C))
+	for i, a := range p.C {
+		q[i] = new(big.Int).Set(a)
+	}
+	return q
+}
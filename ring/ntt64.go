@@ -0,0 +1,184 @@
+package ring
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+var (
+	ErrNTTModulus = errors.New("ring: the NTT modulus must be an odd prime below 2^62")
+	ErrNTTSize    = errors.New("ring: the NTT size must be a power of two dividing M - 1")
+)
+
+// NTT64 is a number-theoretic transform of a fixed power-of-two size modulo a word-sized prime
+// Twiddle factors are stored in Montgomery form next to each other stage by stage, so every butterfly is one
+// Montgomery multiplication (two 64x64 products, no division) on contiguous memory, and the coefficients stay in
+// the ordinary representation in [0, M)
+type NTT64 struct {
+	M    uint64
+	n    int
+	mInv uint64   // -M^-1 mod 2^64
+	tw   []uint64 // tw[h+j] = w_2h^j R mod M for every stage half-size h and j < h
+	itw  []uint64 // the same for w^-1
+	nInv uint64   // n^-1 R mod M
+	nRR  uint64   // n^-1 R^2 mod M, which also cancels the R^-1 of a pointwise Montgomery product
+}
+
+// NewNTT64() precomputes the transform of size n modulo the prime m
+// A primitive n-th root is found as x^((m-1)/n) with a non-trivial (n/2)-th power, so m - 1 need not be factored
+func NewNTT64(m uint64, n int) (*NTT64, error) {
+	if m < 3 || m >= 1<<62 || m%2 == 0 || !new(big.Int).SetUint64(m).ProbablyPrime(20) {
+		return nil, ErrNTTModulus
+	}
+	if n < 1 || n&(n-1) != 0 || (m-1)%uint64(n) != 0 {
+		return nil, ErrNTTSize
+	}
+	f, _ := NewUint64Mod(m)
+	t := &NTT64{M: m, n: n}
+	inv := m // Newton iteration for M^-1 mod 2^64, doubling the correct bits each time
+	for i := 0; i < 5; i++ {
+		inv *= 2 - m*inv
+	}
+	t.mInv = -inv
+	w := uint64(1)
+	for x := uint64(2); n > 1; x++ {
+		w = pow64(f, x, (m-1)/uint64(n))
+		if pow64(f, w, uint64(n/2)) == m-1 {
+			break
+		}
+	}
+	wInv, _ := f.Inv(w)
+	t.tw = t.twiddles(f, w)
+	t.itw = t.twiddles(f, wInv)
+	nInv, _ := f.Inv(uint64(n) % m)
+	t.nInv = t.toMont(nInv)
+	t.nRR = t.toMont(t.nInv)
+	return t, nil
+}
+
+func pow64(f Uint64Mod, x, e uint64) uint64 {
+	r := f.One()
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			r = f.Mul(r, x)
+		}
+		x = f.Mul(x, x)
+	}
+	return r
+}
+
+// toMont() returns a R mod M for R = 2^64
+func (t *NTT64) toMont(a uint64) uint64 {
+	_, r := bits.Div64(a%t.M, 0, t.M)
+	return r
+}
+
+func (t *NTT64) twiddles(f Uint64Mod, w uint64) []uint64 {
+	tw := make([]uint64, t.n)
+	for h := 1; h < t.n; h <<= 1 {
+		// w_2h = w^(n/2h)
+		step := pow64(f, w, uint64(t.n/(2*h)))
+		c := f.One()
+		for j := 0; j < h; j++ {
+			tw[h+j] = t.toMont(c)
+			c = f.Mul(c, step)
+		}
+	}
+	return tw
+}
+
+// mul() returns a b R^-1 mod M (Montgomery reduction), which is a w mod M for b = w R
+// With a, b < M < 2^62 the sum (a b + q M) / 2^64 is below 2M, so one subtraction suffices
+func (t *NTT64) mul(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	q := lo * t.mInv
+	mh, ml := bits.Mul64(q, t.M)
+	_, c := bits.Add64(lo, ml, 0)
+	r := hi + mh + c
+	if r >= t.M {
+		r -= t.M
+	}
+	return r
+}
+
+// Size() returns the transform length
+func (t *NTT64) Size() int {
+	return t.n
+}
+
+func (t *NTT64) transform(a []uint64, tw []uint64) {
+	n := t.n
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+	m := t.M
+	for h := 1; h < n; h <<= 1 {
+		w := tw[h : 2*h]
+		for start := 0; start < n; start += 2 * h {
+			lo, hi := a[start:start+h], a[start+h:start+2*h]
+			for j := range lo {
+				u, v := lo[j], t.mul(hi[j], w[j])
+				s := u + v
+				if s >= m {
+					s -= m
+				}
+				d := u + m - v
+				if d >= m {
+					d -= m
+				}
+				lo[j], hi[j] = s, d
+			}
+		}
+	}
+}
+
+// Forward() transforms a in place into (a(w^0), ..., a(w^(n-1))); len(a) must be Size() and every entry below M
+func (t *NTT64) Forward(a []uint64) {
+	t.transform(a, t.tw)
+}
+
+// Inverse() undoes Forward() in place
+func (t *NTT64) Inverse(a []uint64) {
+	t.inverse(a, t.nInv)
+}
+
+// inverse() runs the transform with w^-1 and multiplies by c R^-1
+func (t *NTT64) inverse(a []uint64, c uint64) {
+	t.transform(a, t.itw)
+	for i := range a {
+		a[i] = t.mul(a[i], c)
+	}
+}
+
+// Convolve() returns the product of the coefficient slices a and b, which must satisfy len(a) + len(b) - 1 <= Size()
+func (t *NTT64) Convolve(a, b []uint64) ([]uint64, error) {
+	if len(a) == 0 || len(b) == 0 {
+		return nil, nil
+	}
+	if len(a)+len(b)-1 > t.n {
+		return nil, ErrNTTSize
+	}
+	fa, fb := make([]uint64, t.n), make([]uint64, t.n)
+	for i, c := range a {
+		fa[i] = c % t.M
+	}
+	for i, c := range b {
+		fb[i] = c % t.M
+	}
+	t.Forward(fa)
+	t.Forward(fb)
+	for i := range fa {
+		fa[i] = t.mul(fa[i], fb[i])
+	}
+	// the pointwise products carry a factor R^-1, removed together with the division by n
+	t.inverse(fa, t.nRR)
+	return fa[:len(a)+len(b)-1], nil
+}
@@ -0,0 +1,101 @@
+package ring
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/jongukim/polynomial"
+)
+
+func TestNTT64(t *testing.T) {
+	rr := rand.New(rand.NewSource(1))
+	cases := []struct {
+		m      uint64
+		n      int
+		la, lb int
+	}{
+		{17, 16, 5, 7},
+		{998244353, 1, 1, 1},
+		{998244353, 2, 1, 2},
+		{998244353, 1024, 500, 525},
+		// 2^62 - 2^16 + 1
+		{4611686018427322369, 256, 128, 129},
+	}
+	for _, c := range cases {
+		tr, err := NewNTT64(c.m, c.n)
+		if err != nil {
+			t.Fatalf("NewNTT64(%d, %d) failed: %v", c.m, c.n, err)
+		}
+		a, b := make([]uint64, c.la), make([]uint64, c.lb)
+		for i := range a {
+			a[i] = rr.Uint64() % c.m
+		}
+		for i := range b {
+			b[i] = rr.Uint64() % c.m
+		}
+		x := make([]uint64, c.n)
+		copy(x, a)
+		tr.Forward(x)
+		tr.Inverse(x)
+		for i := range x {
+			if i < len(a) && x[i] != a[i] || i >= len(a) && x[i] != 0 {
+				t.Fatalf("Inverse(Forward()) mod %d of size %d is not the identity", c.m, c.n)
+			}
+		}
+		got, err := tr.Convolve(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bm := new(big.Int).SetUint64(c.m)
+		pa, pb := make(polynomial.Poly, len(a)), make(polynomial.Poly, len(b))
+		for i := range a {
+			pa[i] = new(big.Int).SetUint64(a[i])
+		}
+		for i := range b {
+			pb[i] = new(big.Int).SetUint64(b[i])
+		}
+		want := pa.Mul(pb, bm)
+		for i := range got {
+			if got[i] != want.Coeff(i).Uint64() {
+				t.Fatalf("Convolve() mod %d of sizes %d x %d differs at %d", c.m, c.la, c.lb, i)
+			}
+		}
+	}
+}
+
+func TestNTT64Errors(t *testing.T) {
+	cases := []struct {
+		m   uint64
+		n   int
+		err error
+	}{
+		{16, 4, ErrNTTModulus},
+		{2, 1, ErrNTTModulus},
+		{15, 2, ErrNTTModulus},
+		{1<<63 - 25, 2, ErrNTTModulus},
+		{998244353, 3, ErrNTTSize},
+		{998244353, 1 << 24, ErrNTTSize},
+	}
+	for _, c := range cases {
+		if _, err := NewNTT64(c.m, c.n); err != c.err {
+			t.Errorf("NewNTT64(%d, %d) error %v, expected %v", c.m, c.n, err, c.err)
+		}
+	}
+	tr, _ := NewNTT64(17, 4)
+	if _, err := tr.Convolve(make([]uint64, 3), make([]uint64, 3)); err != ErrNTTSize {
+		t.Errorf("Convolve() beyond the size: error %v, expected ErrNTTSize", err)
+	}
+}
+
+func BenchmarkNTT64(b *testing.B) {
+	tr, _ := NewNTT64(4611686018427322369, 1<<16)
+	a := make([]uint64, tr.Size())
+	for i := range a {
+		a[i] = uint64(i) * 0x9e3779b97f4a7c15 % tr.M
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tr.Forward(a)
+	}
+}
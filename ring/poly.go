@@ -0,0 +1,184 @@
+package ring
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Poly is a polynomial over the ring R
+// C is an array in reverse like polynomial.Poly: f(x) = 3x^3 + 2x + 1 => [1 2 0 3]
+type Poly[T any] struct {
+	R Ring[T]
+	C []T
+}
+
+var ErrNotInvertible = errors.New("ring: the leading coefficient of the divisor is not invertible")
+
+// New() returns a trimmed polynomial with the given coefficients
+func New[T any](r Ring[T], coeffs ...T) Poly[T] {
+	p := Poly[T]{r, append([]T(nil), coeffs...)}
+	p.trim()
+	return p
+}
+
+// trim() makes sure that the highest coefficient is not zero (the constant is always kept)
+func (p *Poly[T]) trim() {
+	n := len(p.C)
+	for n > 1 && p.R.Equal(p.C[n-1], p.R.Zero()) {
+		n--
+	}
+	if n == 0 {
+		p.C = []T{p.R.Zero()}
+		return
+	}
+	p.C = p.C[:n]
+}
+
+// GetDegree() returns the degree
+func (p Poly[T]) GetDegree() int {
+	return len(p.C) - 1
+}
+
+// IsZero() checks if P = 0
+func (p Poly[T]) IsZero() bool {
+	return len(p.C) == 0 || (len(p.C) == 1 && p.R.Equal(p.C[0], p.R.Zero()))
+}
+
+// Equal() checks if P == Q
+func (p Poly[T]) Equal(q Poly[T]) bool {
+	if len(p.C) != len(q.C) {
+		return false
+	}
+	for i := range p.C {
+		if !p.R.Equal(p.C[i], q.C[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p Poly[T]) String() string {
+	terms := make([]string, 0, len(p.C))
+	for i := len(p.C) - 1; i >= 0; i-- {
+		if i > 0 && p.R.Equal(p.C[i], p.R.Zero()) {
+			continue
+		}
+		switch i {
+		case 0:
+			terms = append(terms, fmt.Sprint(p.C[i]))
+		case 1:
+			terms = append(terms, fmt.Sprintf("%vx", p.C[i]))
+		default:
+			terms = append(terms, fmt.Sprintf("%vx^%d", p.C[i], i))
+		}
+	}
+	return "[" + strings.Join(terms, " + ") + "]"
+}
+
+// Add() adds two polynomials
+func (p Poly[T]) Add(q Poly[T]) Poly[T] {
+	if len(p.C) < len(q.C) {
+		p, q = q, p
+	}
+	r := Poly[T]{p.R, make([]T, len(p.C))}
+	for i := range p.C {
+		if i < len(q.C) {
+			r.C[i] = p.R.Add(p.C[i], q.C[i])
+		} else {
+			r.C[i] = p.C[i]
+		}
+	}
+	r.trim()
+	return r
+}
+
+// Neg() returns -P
+func (p Poly[T]) Neg() Poly[T] {
+	r := Poly[T]{p.R, make([]T, len(p.C))}
+	for i, c := range p.C {
+		r.C[i] = p.R.Neg(c)
+	}
+	return r
+}
+
+// Sub() subtracts Q from P
+func (p Poly[T]) Sub(q Poly[T]) Poly[T] {
+	return p.Add(q.Neg())
+}
+
+// Mul() multiplies two polynomials (schoolbook)
+func (p Poly[T]) Mul(q Poly[T]) Poly[T] {
+	r := Poly[T]{p.R, make([]T, len(p.C)+len(q.C)-1)}
+	for i := range r.C {
+		r.C[i] = p.R.Zero()
+	}
+	for i, a := range p.C {
+		for j, b := range q.C {
+			r.C[i+j] = p.R.Add(r.C[i+j], p.R.Mul(a, b))
+		}
+	}
+	r.trim()
+	return r
+}
+
+// Eval() returns P(x) by Horner's rule
+func (p Poly[T]) Eval(x T) T {
+	y := p.R.Zero()
+	for i := len(p.C) - 1; i >= 0; i-- {
+		y = p.R.Add(p.R.Mul(y, x), p.C[i])
+	}
+	return y
+}
+
+// Div() returns (P / Q, P % Q)
+// The leading coefficient of Q must be invertible in the field F
+func Div[T any](p, q Poly[T], f Field[T]) (quo, rem Poly[T], err error) {
+	if q.IsZero() {
+		return Poly[T]{}, Poly[T]{}, ErrNotInvertible
+	}
+	qd := q.GetDegree()
+	inv, ok := f.Inv(q.C[qd])
+	if !ok {
+		return Poly[T]{}, Poly[T]{}, ErrNotInvertible
+	}
+	rem = Poly[T]{p.R, append([]T(nil), p.C...)}
+	if p.GetDegree() < qd {
+		return New[T](p.R, f.Zero()), rem, nil
+	}
+	quo = Poly[T]{p.R, make([]T, p.GetDegree()-qd+1)}
+	for i := range quo.C {
+		quo.C[i] = f.Zero()
+	}
+	for i := len(rem.C) - 1; i >= qd; i-- {
+		c := f.Mul(rem.C[i], inv)
+		quo.C[i-qd] = c
+		for j := 0; j <= qd; j++ {
+			rem.C[i-qd+j] = f.Add(rem.C[i-qd+j], f.Neg(f.Mul(c, q.C[j])))
+		}
+	}
+	rem.C = rem.C[:qd]
+	rem.trim()
+	quo.trim()
+	return quo, rem, nil
+}
+
+// Gcd() returns the monic greatest common divisor of P and Q over the field F
+func Gcd[T any](p, q Poly[T], f Field[T]) Poly[T] {
+	for !q.IsZero() {
+		_, r, err := Div(p, q, f)
+		if err != nil {
+			break
+		}
+		p, q = q, r
+	}
+	if p.IsZero() {
+		return p
+	}
+	inv, _ := f.Inv(p.C[p.GetDegree()])
+	m := Poly[T]{p.R, make([]T, len(p.C))}
+	for i, c := range p.C {
+		m.C[i] = f.Mul(c, inv)
+	}
+	return m
+}
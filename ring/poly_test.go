@@ -0,0 +1,78 @@
+package ring
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/jongukim/polynomial"
+)
+
+func bigs(vs ...int64) []*big.Int {
+	r := make([]*big.Int, len(vs))
+	for i, v := range vs {
+		r[i] = big.NewInt(v)
+	}
+	return r
+}
+
+func TestBigModPoly(t *testing.T) {
+	f := BigMod{big.NewInt(11)}
+	p := New[*big.Int](f, bigs(4, 0, 0, 3, 0, 1)...)
+	q := New[*big.Int](f, bigs(0, 0, 0, 4, 0, 0, 6)...)
+	if sum, ans := p.Add(q), New[*big.Int](f, bigs(4, 0, 0, 7, 0, 1, 6)...); !sum.Equal(ans) {
+		t.Errorf("%v + %v != %v (your answer was %v)", p, q, ans, sum)
+	}
+	prod := p.Mul(q)
+	quo, rem, err := Div[*big.Int](prod, q, f)
+	if err != nil || !quo.Equal(p) || !rem.IsZero() {
+		t.Errorf("(%v) / %v = (%v, %v), want (%v, 0) (error: %v)", prod, q, quo, rem, p, err)
+	}
+	if v := p.Eval(big.NewInt(2)); v.Int64() != (4+24+32)%11 {
+		t.Errorf("%v at 2 = %v", p, v)
+	}
+	// gcd((x-1)(x-2), (x-1)(x-3)) = x - 1
+	a := New[*big.Int](f, bigs(1, 10)...).Mul(New[*big.Int](f, bigs(9, 1)...))
+	b := New[*big.Int](f, bigs(10, 1)...).Mul(New[*big.Int](f, bigs(8, 1)...))
+	if g, ans := Gcd[*big.Int](a, b, f), New[*big.Int](f, bigs(10, 1)...); !g.Equal(ans) {
+		t.Errorf("gcd(%v, %v) = %v, want %v", a, b, g, ans)
+	}
+}
+
+func TestGF256Poly(t *testing.T) {
+	var g GF256
+	if g.Mul(0x57, 0x83) != 0xc1 {
+		t.Errorf("0x57 * 0x83 = %#x, want 0xc1", g.Mul(0x57, 0x83))
+	}
+	for a := 1; a < 256; a++ {
+		inv, ok := g.Inv(byte(a))
+		if !ok || g.Mul(byte(a), inv) != 1 {
+			t.Fatalf("%#x * %#x != 1", a, inv)
+		}
+	}
+	p := New[byte](g, 1, 2, 3)
+	q := New[byte](g, 7, 1)
+	prod := p.Mul(q)
+	quo, rem, err := Div[byte](prod, q, g)
+	if err != nil || !quo.Equal(p) || !rem.IsZero() {
+		t.Errorf("(%v) / %v = (%v, %v), want (%v, 0) (error: %v)", prod, q, quo, rem, p, err)
+	}
+	if !p.Sub(p).IsZero() {
+		t.Errorf("%v - %v should be zero", p, p)
+	}
+	if _, _, err := Div[byte](p, New[byte](g, 0), g); err != ErrNotInvertible {
+		t.Errorf("division by zero should fail with ErrNotInvertible (got %v)", err)
+	}
+}
+
+func TestPolyConversion(t *testing.T) {
+	m := big.NewInt(11)
+	p := polynomial.NewPolyInts(-1, 5, 13)
+	q := FromPoly(p, m)
+	if ans := New[*big.Int](BigMod{m}, bigs(10, 5, 2)...); !q.Equal(ans) {
+		t.Errorf("FromPoly(%v) = %v, want %v", p, q, ans)
+	}
+	back := ToPoly(q)
+	if ans := polynomial.NewPolyInts(10, 5, 2); back.Compare(&ans) != 0 {
+		t.Errorf("ToPoly(%v) = %v, want %v", q, back, ans)
+	}
+}
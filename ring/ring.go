@@ -0,0 +1,96 @@
+// Package ring implements polynomials over an arbitrary coefficient ring
+// The algorithms are written once against the Ring interface, so machine-word modular
+// integers, GF(2^8) bytes or big integers can be plugged in
+package ring
+
+import "math/big"
+
+// Ring is a commutative ring with identity over elements of type T
+// Implementations must not modify their arguments
+type Ring[T any] interface {
+	Zero() T
+	One() T
+	Add(a, b T) T
+	Neg(a T) T
+	Mul(a, b T) T
+	Equal(a, b T) bool
+}
+
+// Field is a Ring where every non-zero element has an inverse
+// Inv returns false for zero (or for a non-invertible element of a ring that is not really a field)
+type Field[T any] interface {
+	Ring[T]
+	Inv(a T) (T, bool)
+}
+
+// BigMod is Z_M with big integer elements in [0, M)
+type BigMod struct {
+	M *big.Int
+}
+
+func (r BigMod) Zero() *big.Int { return big.NewInt(0) }
+func (r BigMod) One() *big.Int  { return new(big.Int).Mod(big.NewInt(1), r.M) }
+
+func (r BigMod) Add(a, b *big.Int) *big.Int {
+	c := new(big.Int).Add(a, b)
+	return c.Mod(c, r.M)
+}
+
+func (r BigMod) Neg(a *big.Int) *big.Int {
+	c := new(big.Int).Neg(a)
+	return c.Mod(c, r.M)
+}
+
+func (r BigMod) Mul(a, b *big.Int) *big.Int {
+	c := new(big.Int).Mul(a, b)
+	return c.Mod(c, r.M)
+}
+
+func (r BigMod) Equal(a, b *big.Int) bool {
+	return new(big.Int).Mod(a, r.M).Cmp(new(big.Int).Mod(b, r.M)) == 0
+}
+
+func (r BigMod) Inv(a *big.Int) (*big.Int, bool) {
+	c := new(big.Int).ModInverse(a, r.M)
+	return c, c != nil
+}
+
+// GF256 is GF(2^8) with the AES reduction polynomial x^8 + x^4 + x^3 + x + 1
+type GF256 struct{}
+
+func (GF256) Zero() byte           { return 0 }
+func (GF256) One() byte            { return 1 }
+func (GF256) Add(a, b byte) byte   { return a ^ b }
+func (GF256) Neg(a byte) byte      { return a }
+func (GF256) Equal(a, b byte) bool { return a == b }
+
+func (GF256) Mul(a, b byte) byte {
+	var p byte
+	for b != 0 {
+		if b&1 != 0 {
+			p ^= a
+		}
+		hi := a & 0x80
+		a <<= 1
+		if hi != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return p
+}
+
+// Inv() uses a^254 = a^-1
+func (g GF256) Inv(a byte) (byte, bool) {
+	if a == 0 {
+		return 0, false
+	}
+	r, x := byte(1), a
+	for e := 254; e > 0; e >>= 1 {
+		if e&1 != 0 {
+			r = g.Mul(r, x)
+		}
+		x = g.Mul(x, x)
+	}
+	return r, true
+}
@@ -0,0 +1,102 @@
+package ring
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+// Uint64Mod is Z_M with uint64 elements in [0, M) for a modulus M < 2^63
+// Products are reduced with Barrett reduction on the 128-bit product, so no big.Int is involved
+type Uint64Mod struct {
+	M  uint64
+	n  uint   // bit length of M
+	mu uint64 // floor((2^(2n) - 1) / M)
+}
+
+var ErrModulusRange = errors.New("ring: the word-sized modulus must be in [2, 2^63)")
+
+// NewUint64Mod() precomputes the Barrett constant for m
+// floor(2^(2n) / M) is 2^64 when M = 2^62, so the numerator is 2^(2n) - 1 to keep mu in a word
+// This only changes mu when M is a power of two, and then q is at most one smaller
+func NewUint64Mod(m uint64) (Uint64Mod, error) {
+	if m < 2 || m >= 1<<63 {
+		return Uint64Mod{}, ErrModulusRange
+	}
+	n := uint(bits.Len64(m))
+	mu := new(big.Int).Lsh(big.NewInt(1), 2*n)
+	mu.Sub(mu, big.NewInt(1))
+	mu.Div(mu, new(big.Int).SetUint64(m))
+	return Uint64Mod{M: m, n: n, mu: mu.Uint64()}, nil
+}
+
+func (r Uint64Mod) Zero() uint64           { return 0 }
+func (r Uint64Mod) One() uint64            { return 1 % r.M }
+func (r Uint64Mod) Equal(a, b uint64) bool { return a%r.M == b%r.M }
+
+// Add() never overflows since both operands are below 2^63
+func (r Uint64Mod) Add(a, b uint64) uint64 {
+	c := a + b
+	if c >= r.M {
+		c -= r.M
+	}
+	return c
+}
+
+func (r Uint64Mod) Neg(a uint64) uint64 {
+	if a == 0 {
+		return 0
+	}
+	return r.M - a
+}
+
+// Mul() computes a * b mod M
+// With x = a * b < 2^(2n), q = ((x >> (n-1)) * mu) >> (n+1) underestimates x / M by at most 3
+func (r Uint64Mod) Mul(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	s := r.n - 1
+	xs := lo>>s | hi<<(64-s)
+	qh, ql := bits.Mul64(xs, r.mu)
+	t := r.n + 1
+	var q uint64
+	if t == 64 {
+		q = qh
+	} else {
+		q = ql>>t | qh<<(64-t)
+	}
+	ph, pl := bits.Mul64(q, r.M)
+	rl, borrow := bits.Sub64(lo, pl, 0)
+	rh, _ := bits.Sub64(hi, ph, borrow)
+	for rh != 0 || rl >= r.M {
+		rl, borrow = bits.Sub64(rl, r.M, 0)
+		rh -= borrow
+	}
+	return rl
+}
+
+// Inv() uses the extended Euclidean algorithm, so M does not need to be prime
+func (r Uint64Mod) Inv(a uint64) (uint64, bool) {
+	t, newT := int64(0), int64(1)
+	g, newG := int64(r.M), int64(a%r.M)
+	for newG != 0 {
+		q := g / newG
+		t, newT = newT, t-q*newT
+		g, newG = newG, g-q*newG
+	}
+	if g != 1 {
+		return 0, false
+	}
+	if t < 0 {
+		t += int64(r.M)
+	}
+	return uint64(t), true
+}
+
+// FromUint64s() returns a polynomial over F with the given coefficients (reduced modulo M)
+func FromUint64s(f Uint64Mod, coeffs ...uint64) Poly[uint64] {
+	c := make([]uint64, len(coeffs))
+	for i, a := range coeffs {
+		c[i] = a % f.M
+	}
+	return New[uint64](f, c...)
+}
@@ -0,0 +1,102 @@
+package ring
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/jongukim/polynomial"
+)
+
+func TestUint64ModMul(t *testing.T) {
+	moduli := []uint64{2, 3, 17, 998244353, 1<<32 + 15, 4611686018427387847, 1<<63 - 25, 1<<62 + 1}
+	rr := rand.New(rand.NewSource(1))
+	for _, m := range moduli {
+		f, err := NewUint64Mod(m)
+		if err != nil {
+			t.Fatalf("NewUint64Mod(%v) failed: %v", m, err)
+		}
+		bm := new(big.Int).SetUint64(m)
+		for i := 0; i < 1000; i++ {
+			a, b := rr.Uint64()%m, rr.Uint64()%m
+			if i == 0 {
+				a, b = m-1, m-1
+			}
+			want := new(big.Int).Mul(new(big.Int).SetUint64(a), new(big.Int).SetUint64(b))
+			want.Mod(want, bm)
+			if got := f.Mul(a, b); got != want.Uint64() {
+				t.Fatalf("%v * %v mod %v = %v, want %v", a, b, m, got, want)
+			}
+		}
+		if a := uint64(12345) % m; a != 0 {
+			if inv, ok := f.Inv(a); ok && f.Mul(a, inv) != 1 {
+				t.Errorf("%v * %v != 1 mod %v", a, inv, m)
+			}
+		}
+	}
+	if _, err := NewUint64Mod(1 << 63); err != ErrModulusRange {
+		t.Errorf("2^63 is too large for Uint64Mod (error: %v)", err)
+	}
+}
+
+func TestUint64ModPowerOfTwo(t *testing.T) {
+	// floor(2^126 / 2^62) = 2^64 does not fit in the Barrett constant
+	for _, m := range []uint64{2, 1 << 32, 1 << 62} {
+		f, err := NewUint64Mod(m)
+		if err != nil {
+			t.Fatalf("NewUint64Mod(%v) failed: %v", m, err)
+		}
+		a, b := m-1, m-1
+		want := new(big.Int).Mul(new(big.Int).SetUint64(a), new(big.Int).SetUint64(b))
+		want.Mod(want, new(big.Int).SetUint64(m))
+		if got := f.Mul(a, b); got != want.Uint64() {
+			t.Errorf("%v * %v mod %v = %v, want %v", a, b, m, got, want)
+		}
+	}
+}
+
+func TestUint64Poly(t *testing.T) {
+	f, _ := NewUint64Mod(998244353)
+	p := FromUint64s(f, 43, 53, 45, 63, 43, 55, 75)
+	q := FromUint64s(f, 1, 998244352, 1)
+	prod := p.Mul(q)
+	quo, rem, err := Div[uint64](prod, q, f)
+	if err != nil || !quo.Equal(p) || !rem.IsZero() {
+		t.Errorf("(%v) / %v = (%v, %v), want (%v, 0) (error: %v)", prod, q, quo, rem, p, err)
+	}
+	// the same product with polynomial.Poly
+	m := big.NewInt(998244353)
+	bp := polynomial.NewPolyInts(43, 53, 45, 63, 43, 55, 75)
+	bq := polynomial.NewPolyInts(1, -1, 1)
+	ans := bp.Mul(bq, m)
+	for i := range ans {
+		if ans[i].Uint64() != prod.C[i] {
+			t.Errorf("coefficient %v: %v, want %v", i, prod.C[i], ans[i])
+		}
+	}
+}
+
+func BenchmarkUint64Mul(b *testing.B) {
+	f, _ := NewUint64Mod(1<<62 + 1)
+	c := make([]uint64, 256)
+	for i := range c {
+		c[i] = uint64(i) * 0x9e3779b97f4a7c15 % f.M
+	}
+	p := FromUint64s(f, c...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Mul(p)
+	}
+}
+
+func BenchmarkBigIntMul(b *testing.B) {
+	m := new(big.Int).SetUint64(1<<62 + 1)
+	p := make(polynomial.Poly, 256)
+	for i := range p {
+		p[i] = new(big.Int).SetUint64(uint64(i) * 0x9e3779b97f4a7c15 % (1<<62 + 1))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Mul(p, m)
+	}
+}
@@ -0,0 +1,171 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errNegacyclicSize = errors.New("polynomial: the negacyclic degree must be a power of two")
+var errRLWEMessage = errors.New("polynomial: the message has more coefficients than the ring degree")
+
+// NegacyclicRing is Z_q[x]/(x^n + 1) for a power of two n and a prime q = 1 mod 2n
+// Products use the negacyclic NTT: scaling the coefficients by powers of a 2n-th root of unity psi turns the
+// wrap-around with x^n = -1 into a cyclic convolution of length n
+type NegacyclicRing struct {
+	N           int
+	Q           *big.Int
+	psi, psiInv *big.Int
+	w           *big.Int
+}
+
+// NewNegacyclicRing() returns Z_q[x]/(x^n + 1)
+func NewNegacyclicRing(n int, q *big.Int) (*NegacyclicRing, error) {
+	if n < 1 || n&(n-1) != 0 {
+		return nil, errNegacyclicSize
+	}
+	psi, err := nttRoot(2*n, q)
+	if err != nil {
+		return nil, err
+	}
+	w := new(big.Int).Mul(psi, psi)
+	return &NegacyclicRing{n, q, psi, new(big.Int).ModInverse(psi, q), w.Mod(w, q)}, nil
+}
+
+// Reduce() returns P mod (x^n + 1, q)
+func (r *NegacyclicRing) Reduce(p Poly) Poly {
+	return ReduceNegacyclic(p, r.N, r.Q)
+}
+
+// transform() returns the NTT of psi^i p_i
+func (r *NegacyclicRing) transform(p Poly) []*big.Int {
+	p = r.Reduce(p)
+	a := make([]*big.Int, r.N)
+	s := big.NewInt(1)
+	for i := range a {
+		a[i] = p.Coeff(i)
+		a[i].Mul(a[i], s).Mod(a[i], r.Q)
+		s.Mul(s, r.psi).Mod(s, r.Q)
+	}
+	ntt(a, r.w, r.Q)
+	return a
+}
+
+// Mul() returns A * B in the ring
+func (r *NegacyclicRing) Mul(a, b Poly) Poly {
+	x, y := r.transform(a), r.transform(b)
+	for i, c := range x {
+		c.Mul(c, y[i]).Mod(c, r.Q)
+	}
+	inverseNTT(x, r.w, r.Q)
+	s := big.NewInt(1)
+	for _, c := range x {
+		c.Mul(c, s).Mod(c, r.Q)
+		s.Mul(s, r.psiInv).Mod(s, r.Q)
+	}
+	p := Poly(x)
+	p.trim()
+	return p
+}
+
+// Add() returns A + B in the ring
+func (r *NegacyclicRing) Add(a, b Poly) Poly {
+	return r.Reduce(a.Add(b, r.Q))
+}
+
+// Sub() returns A - B in the ring
+func (r *NegacyclicRing) Sub(a, b Poly) Poly {
+	return r.Reduce(a.Sub(b, r.Q))
+}
+
+// Uniform() returns a uniformly random element of the ring
+func (r *NegacyclicRing) Uniform() Poly {
+	p := Poly(randomVector(r.N, r.Q))
+	p.trim()
+	return p
+}
+
+// RLWE is a toy public-key encryption scheme over R_q = Z_q[x]/(x^n + 1) (Lyubashevsky-Peikert-Regev), with
+// messages in R_t and errors from SampleGaussian(n, Sigma)
+// It is meant to show the workflow end to end; the parameters are the caller's responsibility and nothing here
+// runs in constant time
+type RLWE struct {
+	Ring  *NegacyclicRing
+	T     *big.Int
+	Sigma float64
+}
+
+// RLWESecretKey is the small secret s
+type RLWESecretKey struct {
+	S Poly
+}
+
+// RLWEPublicKey is the pair (a, b = a s + e) for a uniform a
+type RLWEPublicKey struct {
+	A, B Poly
+}
+
+// RLWECiphertext is the pair (c0, c1) with c0 - c1 s = floor(q / t) msg + small noise
+type RLWECiphertext struct {
+	C0, C1 Poly
+}
+
+// NewRLWE() sets up the scheme with ring degree n, ciphertext modulus q and plaintext modulus t
+func NewRLWE(n int, q, t *big.Int, sigma float64) (*RLWE, error) {
+	r, err := NewNegacyclicRing(n, q)
+	if err != nil {
+		return nil, err
+	}
+	return &RLWE{r, new(big.Int).Set(t), sigma}, nil
+}
+
+// small() returns a fresh error polynomial reduced into R_q
+func (s *RLWE) small() Poly {
+	return s.Ring.Reduce(SampleGaussian(s.Ring.N, s.Sigma))
+}
+
+// KeyGen() returns a fresh key pair
+func (s *RLWE) KeyGen() (*RLWESecretKey, *RLWEPublicKey) {
+	sk := s.small()
+	a := s.Ring.Uniform()
+	return &RLWESecretKey{sk}, &RLWEPublicKey{a, s.Ring.Add(s.Ring.Mul(a, sk), s.small())}
+}
+
+// delta() returns floor(q / t)
+func (s *RLWE) delta() *big.Int {
+	return new(big.Int).Quo(s.Ring.Q, s.T)
+}
+
+// Encrypt() encrypts msg, whose coefficients are taken modulo t, as (b u + e1 + floor(q / t) msg, a u + e2)
+func (s *RLWE) Encrypt(pk *RLWEPublicKey, msg Poly) (*RLWECiphertext, error) {
+	if len(msg) > s.Ring.N {
+		return nil, errRLWEMessage
+	}
+	msg = msg.Clone(0)
+	msg.sanitize(s.T)
+	d := s.delta()
+	scaled := make(Poly, len(msg))
+	for i, c := range msg {
+		scaled[i] = new(big.Int).Mul(c, d)
+	}
+	u := s.small()
+	c0 := s.Ring.Add(s.Ring.Add(s.Ring.Mul(pk.B, u), s.small()), scaled)
+	return &RLWECiphertext{c0, s.Ring.Add(s.Ring.Mul(pk.A, u), s.small())}, nil
+}
+
+// Decrypt() returns round(t (c0 - c1 s) / q) mod t, which is the message as long as the noise stays below q / 2t
+func (s *RLWE) Decrypt(sk *RLWESecretKey, ct *RLWECiphertext) Poly {
+	v := s.Ring.Sub(ct.C0, s.Ring.Mul(ct.C1, sk.S))
+	half := new(big.Int).Rsh(s.Ring.Q, 1)
+	msg := make(Poly, len(v))
+	for i, c := range v {
+		msg[i] = new(big.Int).Mul(c, s.T)
+		msg[i].Add(msg[i], half).Quo(msg[i], s.Ring.Q).Mod(msg[i], s.T)
+	}
+	msg.trim()
+	return msg
+}
+
+// Add() returns an encryption of the sum of the messages of X and Y; the noises add up
+func (s *RLWE) Add(x, y *RLWECiphertext) *RLWECiphertext {
+	return &RLWECiphertext{s.Ring.Add(x.C0, y.C0), s.Ring.Add(x.C1, y.C1)}
+}
@@ -0,0 +1,66 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNegacyclicRingMul(t *testing.T) {
+	q := big.NewInt(12289)
+	r, err := NewNegacyclicRing(8, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		a, b Poly
+	}{
+		{NewPolyInts(1, 2, 3), NewPolyInts(4, 5)},
+		{NewPolyInts(0, 0, 0, 0, 0, 0, 0, 1), NewPolyInts(0, 1)},
+		{NewPolyInts(3, -1, 4, 1, -5, 9, 2, -6), NewPolyInts(5, 3, -5, 8, 9, -7, 9, 3)},
+	}
+	for _, c := range cases {
+		want := ReduceNegacyclic(c.a.Clone(0).Mul(c.b.Clone(0), nil), 8, q)
+		if got := r.Mul(c.a, c.b); !got.Equal(want) {
+			t.Errorf("Mul(%v, %v) = %v, expected %v", c.a, c.b, got, want)
+		}
+	}
+	if _, err := NewNegacyclicRing(6, q); err != errNegacyclicSize {
+		t.Errorf("NewNegacyclicRing(6) error = %v", err)
+	}
+	if _, err := NewNegacyclicRing(8, big.NewInt(13)); err != errNoNTT {
+		t.Errorf("NewNegacyclicRing(8) mod 13 error = %v", err)
+	}
+}
+
+func TestRLWE(t *testing.T) {
+	s, err := NewRLWE(256, big.NewInt(12289), big.NewInt(2), 3.2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk, pk := s.KeyGen()
+	cases := []Poly{
+		NewPolyInts(0),
+		NewPolyInts(1, 0, 1, 1),
+		RandomPoly(255, 1),
+	}
+	for _, msg := range cases {
+		want := msg.Clone(0)
+		want.sanitize(s.T)
+		want.trim()
+		ct, err := s.Encrypt(pk, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := s.Decrypt(sk, ct); !got.Equal(want) {
+			t.Errorf("Decrypt(Encrypt(%v)) = %v", want, got)
+		}
+	}
+	x, _ := s.Encrypt(pk, NewPolyInts(1, 1, 0, 1))
+	y, _ := s.Encrypt(pk, NewPolyInts(1, 0, 1, 1))
+	if got := s.Decrypt(sk, s.Add(x, y)); !got.Equal(NewPolyInts(0, 1, 1)) {
+		t.Errorf("Decrypt(Add()) = %v, expected x^2 + x", got)
+	}
+	if _, err := s.Encrypt(pk, make(Poly, 257)); err != errRLWEMessage {
+		t.Errorf("Encrypt() of 257 coefficients error = %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package polynomial
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+var errRNSBasis = errors.New("polynomial: the RNS polynomials use different bases")
+
+// RNSPoly is a polynomial whose coefficients are kept as residues modulo the word-sized primes of a CRTBasis,
+// i.e. an element of Z_M[x] stored as one uint64 polynomial per prime: Res[i][j] = p_j mod Primes[i]
+// Arithmetic runs independently on every residue polynomial and never touches a big integer
+type RNSPoly struct {
+	Basis *CRTBasis
+	Res   [][]uint64
+}
+
+// NewRNSPoly() returns the residues of P over the basis
+func NewRNSPoly(b *CRTBasis, p Poly) *RNSPoly {
+	p = p.Clone(0)
+	p.trim()
+	return &RNSPoly{b, b.Reduce(p)}
+}
+
+// sameBasis() checks that A and B are stored over the same primes
+func (a *RNSPoly) sameBasis(b *RNSPoly) bool {
+	if len(a.Basis.Primes) != len(b.Basis.Primes) {
+		return false
+	}
+	for i, p := range a.Basis.Primes {
+		if b.Basis.Primes[i] != p {
+			return false
+		}
+	}
+	return true
+}
+
+// addResidue() returns a + b modulo p, or a - b when neg is set
+func addResidue(a, b []uint64, p uint64, neg bool) []uint64 {
+	n := len(a)
+	if len(b) > n {
+		n = len(b)
+	}
+	r := make([]uint64, n)
+	copy(r, a)
+	for j, y := range b {
+		if neg && y != 0 {
+			y = p - y
+		}
+		// both terms are below p < 2^63 (checked by NewCRTBasis()), so the sum does not overflow
+		if r[j] += y; r[j] >= p {
+			r[j] -= p
+		}
+	}
+	return r
+}
+
+// combine() applies f prime by prime to A and B
+func (a *RNSPoly) combine(b *RNSPoly, f func(x, y []uint64, p uint64) []uint64) (*RNSPoly, error) {
+	if !a.sameBasis(b) {
+		return nil, errRNSBasis
+	}
+	return &RNSPoly{a.Basis, a.Basis.Apply(a.Res, func(i int, p uint64, x []uint64) []uint64 {
+		return f(x, b.Res[i], p)
+	})}, nil
+}
+
+// Add() returns A + B
+func (a *RNSPoly) Add(b *RNSPoly) (*RNSPoly, error) {
+	return a.combine(b, func(x, y []uint64, p uint64) []uint64 { return addResidue(x, y, p, false) })
+}
+
+// Sub() returns A - B
+func (a *RNSPoly) Sub(b *RNSPoly) (*RNSPoly, error) {
+	return a.combine(b, func(x, y []uint64, p uint64) []uint64 { return addResidue(x, y, p, true) })
+}
+
+// Mul() returns A * B
+func (a *RNSPoly) Mul(b *RNSPoly) (*RNSPoly, error) {
+	return a.combine(b, mulResidue)
+}
+
+// ToPoly() reconstructs the coefficients exactly by CRT, in [0, M) or with signed set in [-M/2, M/2)
+func (a *RNSPoly) ToPoly(signed bool) (Poly, error) {
+	return a.Basis.Reconstruct(a.Res, signed)
+}
+
+// Extend() returns the same polynomial over another basis without leaving word-sized arithmetic: with
+// v_i = r_i (M / p_i)^-1 mod p_i, the centered coefficient is x = sum v_i (M / p_i) - alpha M for
+// alpha = round(sum v_i / p_i), so its residue modulo every new prime q follows from (M / p_i) mod q and M mod q
+// alpha is estimated in float64, so the result is exact unless x lies within about len(Primes) 2^-52 M of +-M/2
+func (a *RNSPoly) Extend(to *CRTBasis) *RNSPoly {
+	b := a.Basis
+	k := len(b.Primes)
+	mij := make([][]uint64, len(to.Primes))
+	mq := make([]uint64, len(to.Primes))
+	bq := new(big.Int)
+	for j, q := range to.Primes {
+		bq.SetUint64(q)
+		mij[j] = make([]uint64, k)
+		for i := range b.Primes {
+			mij[j][i] = new(big.Int).Mod(b.mi[i], bq).Uint64()
+		}
+		mq[j] = new(big.Int).Mod(b.M, bq).Uint64()
+	}
+	n := len(a.Res[0])
+	res := make([][]uint64, len(to.Primes))
+	for j := range res {
+		res[j] = make([]uint64, n)
+	}
+	v := make([]uint64, k)
+	for c := 0; c < n; c++ {
+		frac := 0.0
+		for i, p := range b.Primes {
+			v[i] = mulMod64(a.Res[i][c], b.yi[i].Uint64(), p)
+			frac += float64(v[i]) / float64(p)
+		}
+		alpha := uint64(math.Floor(frac + 0.5))
+		for j, q := range to.Primes {
+			s := uint64(0)
+			for i := range v {
+				if s += mulMod64(v[i]%q, mij[j][i], q); s >= q {
+					s -= q
+				}
+			}
+			t := mulMod64(alpha%q, mq[j], q)
+			if s < t {
+				s += q
+			}
+			res[j][c] = s - t
+		}
+	}
+	return &RNSPoly{to, res}
+}
@@ -0,0 +1,59 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRNSPolyArithmetic(t *testing.T) {
+	b, _ := NewCRTBasis(WordPrimes(3))
+	p := NewPolyInts(5, -7, 0, 3)
+	q := RandomPoly(6, 100)
+	q[2].Neg(q[2])
+	a, c := NewRNSPoly(b, p), NewRNSPoly(b, q)
+	cases := []struct {
+		op   func(x, y *RNSPoly) (*RNSPoly, error)
+		want Poly
+	}{
+		{(*RNSPoly).Add, p.Add(q, nil)},
+		{(*RNSPoly).Sub, p.Sub(q, nil)},
+		{(*RNSPoly).Mul, p.Clone(0).Mul(q.Clone(0), nil)},
+	}
+	for i, cs := range cases {
+		r, err := cs.op(a, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := r.ToPoly(true); !got.Equal(cs.want) {
+			t.Errorf("case %d: %v, expected %v", i, got, cs.want)
+		}
+	}
+	other, _ := NewCRTBasis(WordPrimes(2))
+	if _, err := a.Add(NewRNSPoly(other, p)); err != errRNSBasis {
+		t.Errorf("Add() over different bases error = %v", err)
+	}
+	if got, _ := a.ToPoly(false); !got.Equal(NewPolyInts(5, 0, 0, 3).Add(Poly{new(big.Int).Sub(b.M, big.NewInt(7))}.Clone(1), nil)) {
+		t.Errorf("unsigned ToPoly() = %v", got)
+	}
+}
+
+func TestRNSPolyExtend(t *testing.T) {
+	ps := WordPrimes(5)
+	from, _ := NewCRTBasis(ps[:3])
+	to, _ := NewCRTBasis(ps[3:])
+	wide, _ := NewCRTBasis([]uint64{ps[0], 97, ps[4]})
+	// coefficients across the signed range of the source basis, at least 2^-48 M away from +-M/2
+	half, gap := new(big.Int).Rsh(from.M, 1), new(big.Int).Rsh(from.M, 48)
+	p := Poly{big.NewInt(0), big.NewInt(-1), big.NewInt(123456789), new(big.Int).Sub(gap, half), new(big.Int).Sub(half, gap), RandomBigInt(20)}
+	for _, b := range []*CRTBasis{to, wide} {
+		e := NewRNSPoly(from, p).Extend(b)
+		want := NewRNSPoly(b, p)
+		for i := range want.Res {
+			for j := range want.Res[i] {
+				if e.Res[i][j] != want.Res[i][j] {
+					t.Errorf("Extend() modulo %d: coefficient %d = %d, expected %d", b.Primes[i], j, e.Res[i][j], want.Res[i][j])
+				}
+			}
+		}
+	}
+}
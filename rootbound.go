@@ -0,0 +1,104 @@
+package polynomial
+
+import "math/big"
+
+// ratios() returns |a_i / a_n| for i < n, where a_n is the leading coefficient
+func (p Poly) ratios() []*big.Rat {
+	n := p.GetDegree()
+	rs := make([]*big.Rat, n)
+	for i := range rs {
+		rs[i] = new(big.Rat).SetFrac(new(big.Int).Abs(p[i]), new(big.Int).Abs(p[n]))
+	}
+	return rs
+}
+
+// CauchyBound() returns 1 + max |a_i / a_n|, which bounds the absolute value of every complex root
+// A constant has no roots and gets 0
+func (p Poly) CauchyBound() *big.Rat {
+	q := p.Clone(0)
+	q.trim()
+	if q.GetDegree() < 1 {
+		return new(big.Rat)
+	}
+	b := new(big.Rat)
+	for _, r := range q.ratios() {
+		if r.Cmp(b) > 0 {
+			b.Set(r)
+		}
+	}
+	return b.Add(b, big.NewRat(1, 1))
+}
+
+// LagrangeBound() returns max(1, sum |a_i / a_n|), which bounds the absolute value of every complex root
+// A constant has no roots and gets 0
+func (p Poly) LagrangeBound() *big.Rat {
+	q := p.Clone(0)
+	q.trim()
+	if q.GetDegree() < 1 {
+		return new(big.Rat)
+	}
+	b := new(big.Rat)
+	for _, r := range q.ratios() {
+		b.Add(b, r)
+	}
+	if b.Cmp(big.NewRat(1, 1)) < 0 {
+		b.SetInt64(1)
+	}
+	return b
+}
+
+// FujiwaraBound() returns an integer upper bound on 2 max(|a_(n-1) / a_n|, |a_(n-2) / a_n|^(1/2), ...,
+// |a_0 / 2a_n|^(1/n)), which bounds the absolute value of every complex root and is usually the tightest
+// Each k-th root is rounded up, so the result is at most 2 more than the real Fujiwara bound
+// A constant has no roots and gets 0
+func (p Poly) FujiwaraBound() *big.Rat {
+	q := p.Clone(0)
+	q.trim()
+	n := q.GetDegree()
+	if n < 1 {
+		return new(big.Rat)
+	}
+	rs := q.ratios()
+	rs[0].Quo(rs[0], big.NewRat(2, 1))
+	b := new(big.Int)
+	for i, r := range rs {
+		if c := ceilRoot(r, n-i); c.Cmp(b) > 0 {
+			b = c
+		}
+	}
+	return new(big.Rat).SetInt(b.Lsh(b, 1))
+}
+
+// RootBound() returns the smallest of the Cauchy, Lagrange and Fujiwara bounds
+func (p Poly) RootBound() *big.Rat {
+	b := p.CauchyBound()
+	for _, c := range []*big.Rat{p.LagrangeBound(), p.FujiwaraBound()} {
+		if c.Cmp(b) < 0 {
+			b = c
+		}
+	}
+	return b
+}
+
+// ceilRoot() returns the smallest integer r >= 0 with r^k >= q
+func ceilRoot(q *big.Rat, k int) *big.Int {
+	// ceil(q) has the same ceiling k-th root as q
+	c := new(big.Int).Add(q.Num(), new(big.Int).Sub(q.Denom(), big.NewInt(1)))
+	c.Quo(c, q.Denom())
+	if c.Sign() == 0 {
+		return c
+	}
+	// binary search in [0, 2^(bitlen / k + 1)]
+	lo, hi := big.NewInt(0), new(big.Int).Lsh(big.NewInt(1), uint(c.BitLen()/k+1))
+	kk := big.NewInt(int64(k))
+	for new(big.Int).Sub(hi, lo).Cmp(big.NewInt(1)) > 0 {
+		mid := new(big.Int).Add(lo, hi)
+		mid.Rsh(mid, 1)
+		if new(big.Int).Exp(mid, kk, nil).Cmp(c) >= 0 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi
+}
@@ -0,0 +1,65 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRootBound(t *testing.T) {
+	cases := []struct {
+		p                          Poly
+		cauchy, lagrange, fujiwara *big.Rat
+		largest                    int64
+	}{
+		// roots 1, 2, 3: ratios 6, 11, 6
+		{Wilkinson(3), big.NewRat(12, 1), big.NewRat(23, 1), big.NewRat(12, 1), 3},
+		// 2x^2 - 8 has roots +-2: ratios 4, 0
+		{NewPolyInts(-8, 0, 2), big.NewRat(5, 1), big.NewRat(4, 1), big.NewRat(4, 1), 2},
+		// 4x^2 + x + 1 has complex roots of modulus 1/2
+		{NewPolyInts(1, 1, 4), big.NewRat(5, 4), big.NewRat(1, 1), big.NewRat(2, 1), 0},
+		{NewPolyInts(7), new(big.Rat), new(big.Rat), new(big.Rat), 0},
+	}
+	for _, c := range cases {
+		if got := c.p.CauchyBound(); got.Cmp(c.cauchy) != 0 {
+			t.Errorf("CauchyBound(%v) = %v, want %v", c.p, got, c.cauchy)
+		}
+		if got := c.p.LagrangeBound(); got.Cmp(c.lagrange) != 0 {
+			t.Errorf("LagrangeBound(%v) = %v, want %v", c.p, got, c.lagrange)
+		}
+		if got := c.p.FujiwaraBound(); got.Cmp(c.fujiwara) != 0 {
+			t.Errorf("FujiwaraBound(%v) = %v, want %v", c.p, got, c.fujiwara)
+		}
+		if got := c.p.RootBound(); got.Cmp(big.NewRat(c.largest, 1)) < 0 {
+			t.Errorf("RootBound(%v) = %v is below the root %d", c.p, got, c.largest)
+		}
+	}
+
+	// every real root lies inside the bound
+	for _, p := range []Poly{SwinnertonDyer(3), Mignotte(5, big.NewInt(10)), NewPolyInts(-1000, 0, 0, 1)} {
+		b := p.RootBound()
+		bi := new(big.Int).Quo(b.Num(), b.Denom())
+		bi.Add(bi, big.NewInt(1))
+		if n, all := p.CountRealRoots(new(big.Int).Neg(bi), bi), p.CountRealRoots(nil, nil); n != all {
+			t.Errorf("%v has %d real roots but only %d within %v", p, all, n, b)
+		}
+	}
+}
+
+func TestCeilRoot(t *testing.T) {
+	cases := []struct {
+		q    *big.Rat
+		k    int
+		want int64
+	}{
+		{big.NewRat(27, 1), 3, 3},
+		{big.NewRat(28, 1), 3, 4},
+		{big.NewRat(1, 3), 2, 1},
+		{big.NewRat(0, 1), 5, 0},
+		{big.NewRat(1000001, 1), 2, 1001},
+	}
+	for _, c := range cases {
+		if got := ceilRoot(c.q, c.k); got.Int64() != c.want {
+			t.Errorf("ceilRoot(%v, %d) = %v, want %d", c.q, c.k, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package polynomial
+
+import "math/big"
+
+// ScaleVar() returns P(cx) = sum a_i c^i x^i, whose roots are those of P divided by c
+// modulo m can be nil
+func (p Poly) ScaleVar(c, m *big.Int) Poly {
+	q := make(Poly, len(p))
+	pow := big.NewInt(1)
+	for i, a := range p {
+		q[i] = new(big.Int).Mul(a, pow)
+		pow.Mul(pow, c)
+		if m != nil {
+			pow.Mod(pow, m)
+		}
+	}
+	q.sanitize(m)
+	q.trim()
+	return q
+}
+
+// ReverseScale() returns c^n P(x / c) = sum a_i c^(n-i) x^i, whose roots are those of P multiplied by c
+// It stays an integer polynomial, and is monic when P is monic
+// modulo m can be nil
+func (p Poly) ReverseScale(c, m *big.Int) Poly {
+	q := p.Clone(0)
+	q.trim()
+	pow := big.NewInt(1)
+	for i := q.GetDegree(); i >= 0; i-- {
+		q[i].Mul(q[i], pow)
+		pow.Mul(pow, c)
+		if m != nil {
+			pow.Mod(pow, m)
+		}
+	}
+	q.sanitize(m)
+	q.trim()
+	return q
+}
@@ -0,0 +1,31 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestScaleVar(t *testing.T) {
+	cases := []struct {
+		p              Poly
+		c              int64
+		m              *big.Int
+		scale, reverse Poly
+	}{
+		// roots 2, 4 become 1, 2 and 4, 8
+		{RootPoly(bigs(2, 4), nil), 2, nil, NewPolyInts(8, -12, 4), RootPoly(bigs(4, 8), nil)},
+		{NewPolyInts(1, 1, 1), -1, nil, NewPolyInts(1, -1, 1), NewPolyInts(1, -1, 1)},
+		{NewPolyInts(5), 3, nil, NewPolyInts(5), NewPolyInts(5)},
+		{NewPolyInts(1, 2, 3), 10, big.NewInt(7), NewPolyInts(1, 6, 6), NewPolyInts(2, 6, 3)},
+		// scaling by 0 keeps the constant term of P(cx) and the leading term of c^n P(x/c)
+		{NewPolyInts(1, 2, 3), 0, nil, NewPolyInts(1), NewPolyInts(0, 0, 3)},
+	}
+	for _, c := range cases {
+		if got := c.p.ScaleVar(big.NewInt(c.c), c.m); got.Compare(&c.scale) != 0 {
+			t.Errorf("ScaleVar(%v, %d) mod %v = %v, want %v", c.p, c.c, c.m, got, c.scale)
+		}
+		if got := c.p.ReverseScale(big.NewInt(c.c), c.m); got.Compare(&c.reverse) != 0 {
+			t.Errorf("ReverseScale(%v, %d) mod %v = %v, want %v", c.p, c.c, c.m, got, c.reverse)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package polynomial
+
+import (
+	"crypto/sha3"
+	"math/big"
+)
+
+// seedCustomization separates the SHAKE stream of PolyFromSeed() from other uses of the same seed
+var seedCustomization = []byte("github.com/jongukim/polynomial PolyFromSeed")
+
+// PolyFromSeed() expands a seed into a polynomial of degree at most degree (degree + 1 coefficients) that is
+// uniform modulo q, so a protocol can send the seed instead of the polynomial
+// The coefficients are read in turn from cSHAKE256(seed) as big-endian integers of the byte length of q with the
+// bits above q.BitLen() cleared and are rejected when not below q, so there is no modulo bias; the expected
+// number of draws per coefficient is below 2
+// Panics if degree is negative or q < 2
+func PolyFromSeed(seed []byte, degree int, q *big.Int) Poly {
+	if degree < 0 || q.Cmp(big.NewInt(2)) < 0 {
+		panic("polynomial: PolyFromSeed() needs a degree >= 0 and a modulus >= 2")
+	}
+	h := sha3.NewCSHAKE256(nil, seedCustomization)
+	h.Write(seed)
+	bits := q.BitLen()
+	buf := make([]byte, (bits+7)/8)
+	top := byte(0xff >> (8*len(buf) - bits))
+	p := make(Poly, degree+1)
+	for i := range p {
+		for {
+			h.Read(buf)
+			buf[0] &= top
+			if c := new(big.Int).SetBytes(buf); c.Cmp(q) < 0 {
+				p[i] = c
+				break
+			}
+		}
+	}
+	p.trim()
+	return p
+}
@@ -0,0 +1,39 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestPolyFromSeed(t *testing.T) {
+	q := big.NewInt(12289)
+	seed := []byte("0123456789abcdef0123456789abcdef")
+	p := PolyFromSeed(seed, 255, q)
+	if !p.Equal(PolyFromSeed(seed, 255, q)) {
+		t.Errorf("PolyFromSeed() is not deterministic")
+	}
+	if p.Equal(PolyFromSeed([]byte("0123456789abcdef0123456789abcdeg"), 255, q)) {
+		t.Errorf("different seeds gave the same polynomial")
+	}
+	if err := p.CheckInvariants(q); err != nil || p.GetDegree() > 255 {
+		t.Errorf("PolyFromSeed(): degree %d, %v", p.GetDegree(), err)
+	}
+	// a shorter polynomial is a prefix of the same stream
+	if short := PolyFromSeed(seed, 15, q); !short.Equal(p.Trunc(16)) {
+		t.Errorf("PolyFromSeed(15) = %v is not a prefix of PolyFromSeed(255)", short)
+	}
+	// the residues of modulus 3 have no bias: 3000 draws of each about 1000 times
+	counts := make([]int, 3)
+	for _, c := range PolyFromSeed(seed, 2999, big.NewInt(3)) {
+		counts[c.Int64()]++
+	}
+	for r, n := range counts {
+		if n < 880 || n > 1120 {
+			t.Errorf("residue %d drawn %d times out of 3000", r, n)
+		}
+	}
+	pow := new(big.Int).Lsh(big.NewInt(1), 255)
+	if p := PolyFromSeed(seed, 3, pow); p.CheckInvariants(pow) != nil {
+		t.Errorf("PolyFromSeed() modulo 2^255 = %v", p)
+	}
+}
@@ -0,0 +1,151 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errSeriesNotInvertible = errors.New("polynomial: the constant term is not invertible")
+
+// InverseSeries() returns G with P * G = 1 mod x^n by Newton iteration
+// G_2k = G_k * (2 - P * G_k) mod x^2k, so only O(log n) multiplications are needed
+// The constant term must be invertible modulo m (or be 1 or -1 without a modulus)
+func (p Poly) InverseSeries(n int, m *big.Int) (Poly, error) {
+	c := p.ConstantTerm()
+	var inv *big.Int
+	if m != nil {
+		inv = new(big.Int).ModInverse(c.Mod(c, m), m)
+	} else if c.CmpAbs(big.NewInt(1)) == 0 {
+		inv = c
+	}
+	if inv == nil {
+		return nil, errSeriesNotInvertible
+	}
+	if n <= 0 {
+		return NewPolyInts(0), nil
+	}
+	g := Poly{inv}
+	two := NewPolyInts(2)
+	for k := 1; k < n; {
+		k *= 2
+		if k > n {
+			k = n
+		}
+		e := p.Trunc(k).Mul(g.Clone(0), m).Trunc(k)
+		g = g.Mul(two.Sub(e, m), m).Trunc(k)
+	}
+	return g, nil
+}
+
+var (
+	errSeriesModulus = errors.New("polynomial: the modulus must be a prime larger than the number of terms")
+	errLogConstant   = errors.New("polynomial: log needs a constant term equal to 1")
+	errExpConstant   = errors.New("polynomial: exp needs a zero constant term")
+	errSqrtConstant  = errors.New("polynomial: the constant term has no square root")
+)
+
+// Derivative() returns the formal derivative P'
+// modulo m can be nil
+func (p Poly) Derivative(m *big.Int) Poly {
+	if len(p) <= 1 {
+		return NewPolyInts(0)
+	}
+	q := make(Poly, len(p)-1)
+	for i := 1; i < len(p); i++ {
+		q[i-1] = new(big.Int).Mul(p[i], big.NewInt(int64(i)))
+	}
+	q.sanitize(m)
+	q.trim()
+	return q
+}
+
+// checkSeriesModulus() makes sure 1, 2, ..., n are invertible modulo m
+func checkSeriesModulus(n int, m *big.Int) error {
+	if m == nil || m.Cmp(big.NewInt(int64(n))) <= 0 {
+		return errSeriesModulus
+	}
+	return nil
+}
+
+// LogSeries() returns log(P) mod x^n = integral of P'/P, for P(0) = 1 modulo a prime m > n
+func (p Poly) LogSeries(n int, m *big.Int) (Poly, error) {
+	if err := checkSeriesModulus(n, m); err != nil {
+		return nil, err
+	}
+	if c := p.ConstantTerm(); c.Mod(c, m).Cmp(big.NewInt(1)) != 0 {
+		return nil, errLogConstant
+	}
+	if n <= 0 {
+		return NewPolyInts(0), nil
+	}
+	inv, err := p.InverseSeries(n, m)
+	if err != nil {
+		return nil, err
+	}
+	d := p.Trunc(n).Derivative(m).Mul(inv, m).Trunc(n - 1)
+	return d.Integral(m)
+}
+
+// ExpSeries() returns exp(P) mod x^n for P(0) = 0 modulo a prime m > n
+// Newton iteration: G_2k = G_k * (1 - log(G_k) + P) mod x^2k
+func (p Poly) ExpSeries(n int, m *big.Int) (Poly, error) {
+	if err := checkSeriesModulus(n, m); err != nil {
+		return nil, err
+	}
+	if c := p.ConstantTerm(); c.Mod(c, m).Sign() != 0 {
+		return nil, errExpConstant
+	}
+	if n <= 0 {
+		return NewPolyInts(0), nil
+	}
+	g := NewPolyInts(1)
+	for k := 1; k < n; {
+		k *= 2
+		if k > n {
+			k = n
+		}
+		l, err := g.LogSeries(k, m)
+		if err != nil {
+			return nil, err
+		}
+		e := NewPolyInts(1).Sub(l, m).Add(p.Trunc(k), m)
+		g = g.Mul(e, m).Trunc(k)
+	}
+	return g, nil
+}
+
+// SqrtSeries() returns G with G^2 = P mod x^n modulo an odd prime m > n
+// The constant term must be a non-zero quadratic residue, and the root whose constant
+// term is big.Int.ModSqrt() of it is chosen
+// Newton iteration: G_2k = (G_k + P / G_k) / 2 mod x^2k
+func (p Poly) SqrtSeries(n int, m *big.Int) (Poly, error) {
+	if err := checkSeriesModulus(n, m); err != nil {
+		return nil, err
+	}
+	c := p.ConstantTerm()
+	c.Mod(c, m)
+	if c.Sign() == 0 {
+		return nil, errSqrtConstant
+	}
+	r := new(big.Int).ModSqrt(c, m)
+	if r == nil {
+		return nil, errSqrtConstant
+	}
+	if n <= 0 {
+		return NewPolyInts(0), nil
+	}
+	half := Poly{new(big.Int).ModInverse(big.NewInt(2), m)}
+	g := Poly{r}
+	for k := 1; k < n; {
+		k *= 2
+		if k > n {
+			k = n
+		}
+		inv, err := g.InverseSeries(k, m)
+		if err != nil {
+			return nil, err
+		}
+		g = g.Add(p.Trunc(k).Mul(inv, m).Trunc(k), m).Mul(half.Clone(0), m)
+	}
+	return g, nil
+}
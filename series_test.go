@@ -0,0 +1,140 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestInverseSeries(t *testing.T) {
+	m := big.NewInt(998244353)
+	cases := []struct {
+		p   Poly
+		n   int
+		m   *big.Int
+		ans Poly
+	}{
+		// 1 / (1 - x) = 1 + x + x^2 + ...
+		{NewPolyInts(1, -1), 5, nil, NewPolyInts(1, 1, 1, 1, 1)},
+		// 1 / (1 - x - x^2) generates the Fibonacci numbers
+		{NewPolyInts(1, -1, -1), 10, nil, NewPolyInts(1, 1, 2, 3, 5, 8, 13, 21, 34, 55)},
+		{NewPolyInts(-1, 0, 1), 4, nil, NewPolyInts(-1, 0, -1)},
+		{NewPolyInts(3), 1, big.NewInt(7), NewPolyInts(5)},
+		{NewPolyInts(7), 0, big.NewInt(11), NewPolyInts(0)},
+	}
+	for _, c := range cases {
+		res, err := c.p.InverseSeries(c.n, c.m)
+		if err != nil || res.Compare(&c.ans) != 0 {
+			t.Errorf("1 / %v mod x^%v != %v (your answer was %v, error: %v)", c.p, c.n, c.ans, res, err)
+		}
+	}
+
+	p := NewPolyInts(43, 53, 45, 63, 43, 55, 75)
+	for _, n := range []int{1, 2, 3, 7, 16, 33} {
+		g, err := p.InverseSeries(n, m)
+		if err != nil {
+			t.Fatalf("InverseSeries(%v) failed: %v", n, err)
+		}
+		one := p.Clone(0).Mul(g, m).Trunc(n)
+		if ans := NewPolyInts(1); one.Compare(&ans) != 0 {
+			t.Errorf("%v * %v mod x^%v = %v, want 1", p, g, n, one)
+		}
+	}
+
+	if _, err := NewPolyInts(2, 1).InverseSeries(3, nil); err == nil {
+		t.Errorf("2 is not invertible over Z")
+	}
+	if _, err := NewPolyInts(0, 1).InverseSeries(3, m); err == nil {
+		t.Errorf("a zero constant term is not invertible")
+	}
+}
+
+func TestDerivative(t *testing.T) {
+	cases := []struct {
+		p   Poly
+		m   *big.Int
+		ans Poly
+	}{
+		{NewPolyInts(5, 4, 3, 2), nil, NewPolyInts(4, 6, 6)},
+		{NewPolyInts(5), nil, NewPolyInts(0)},
+		{NewPolyInts(1, 1, 1, 1, 1, 1, 1, 1), big.NewInt(7), NewPolyInts(1, 2, 3, 4, 5, 6)},
+	}
+	for _, c := range cases {
+		res := c.p.Derivative(c.m)
+		if res.Compare(&c.ans) != 0 {
+			t.Errorf("(%v)' != %v (your answer was %v)", c.p, c.ans, res)
+		}
+	}
+}
+
+func TestLogExpSeries(t *testing.T) {
+	m := big.NewInt(998244353)
+	n := 12
+	// exp(x) = sum x^k / k!
+	e, err := NewPolyInts(0, 1).ExpSeries(n, m)
+	if err != nil {
+		t.Fatalf("ExpSeries() failed: %v", err)
+	}
+	fact := big.NewInt(1)
+	for k := 0; k < n; k++ {
+		if k > 0 {
+			fact.Mul(fact, big.NewInt(int64(k)))
+		}
+		want := new(big.Int).ModInverse(fact, m)
+		if e.Coeff(k).Cmp(want) != 0 {
+			t.Errorf("coefficient %v of exp(x) is %v, want 1/%v! = %v", k, e.Coeff(k), k, want)
+		}
+	}
+	// log(1 + x) = x - x^2/2 + x^3/3 - ...
+	l, err := NewPolyInts(1, 1).LogSeries(n, m)
+	if err != nil {
+		t.Fatalf("LogSeries() failed: %v", err)
+	}
+	for k := 1; k < n; k++ {
+		want := new(big.Int).ModInverse(big.NewInt(int64(k)), m)
+		if k%2 == 0 {
+			want.Sub(m, want)
+		}
+		if l.Coeff(k).Cmp(want) != 0 {
+			t.Errorf("coefficient %v of log(1 + x) is %v, want %v", k, l.Coeff(k), want)
+		}
+	}
+	// exp(log(P)) = P
+	p := NewPolyInts(1, 53, 45, 63, 43, 55, 75)
+	lp, _ := p.LogSeries(20, m)
+	back, err := lp.ExpSeries(20, m)
+	if err != nil || back.Compare(&p) != 0 {
+		t.Errorf("exp(log(%v)) = %v (error: %v)", p, back, err)
+	}
+
+	if _, err := NewPolyInts(2, 1).LogSeries(5, m); err == nil {
+		t.Errorf("log needs a constant term of 1")
+	}
+	if _, err := NewPolyInts(1, 1).ExpSeries(5, m); err == nil {
+		t.Errorf("exp needs a zero constant term")
+	}
+	if _, err := NewPolyInts(0, 1).ExpSeries(20, big.NewInt(13)); err == nil {
+		t.Errorf("20 terms need a modulus larger than 20")
+	}
+}
+
+func TestSqrtSeries(t *testing.T) {
+	m := big.NewInt(998244353)
+	g := NewPolyInts(3, 1, 4, 1, 5, 9, 2, 6)
+	p := g.Clone(0).Mul(g.Clone(0), m)
+	r, err := p.SqrtSeries(8, m)
+	if err != nil {
+		t.Fatalf("SqrtSeries(%v) failed: %v", p, err)
+	}
+	sq := r.Clone(0).Mul(r.Clone(0), m).Trunc(8)
+	if want := p.Trunc(8); sq.Compare(&want) != 0 {
+		t.Errorf("(%v)^2 mod x^8 = %v, want %v", r, sq, want)
+	}
+	neg := g.Neg()
+	neg.sanitize(m)
+	if r.Compare(&g) != 0 && r.Compare(&neg) != 0 {
+		t.Errorf("sqrt(%v) = %v, want +-%v", p, r, g)
+	}
+	if _, err := NewPolyInts(3, 1).SqrtSeries(4, big.NewInt(7)); err == nil {
+		t.Errorf("3 is not a square modulo 7")
+	}
+}
@@ -0,0 +1,90 @@
+package polynomial
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+var (
+	errShareCode     = errors.New("polynomial: malformed share code")
+	errShareChecksum = errors.New("polynomial: share code checksum mismatch")
+	errShareModulus  = errors.New("polynomial: share code belongs to another modulus")
+)
+
+// shareCodePrefix starts every share code, like the human-readable part of bech32
+const shareCodePrefix = "ps1"
+
+// ModulusID() returns the 4-byte identifier of a modulus that share codes carry: the head of SHA-256 of its
+// big-endian bytes
+func ModulusID(m *big.Int) uint32 {
+	h := sha256.Sum256(m.Bytes())
+	return binary.BigEndian.Uint32(h[:4])
+}
+
+// shareChecksum() returns the 4-byte checksum of a share code payload
+func shareChecksum(b []byte) []byte {
+	h := sha256.Sum256(append([]byte(shareCodePrefix), b...))
+	return h[:4]
+}
+
+// EncodeShare() packs one share of a threshold-out-of-n sharing modulo m into a short string that is safe in URLs
+// and QR codes: "ps1" followed by the unpadded base64url of
+//
+//	len(x) (uvarint) | x | threshold (uvarint) | ModulusID(m) (4 bytes) | y (padded to the size of m) | checksum (4 bytes)
+//
+// The checksum is the head of SHA-256 over the prefix and payload, so a mistyped share is rejected instead of
+// silently recovering a wrong secret, except with probability 2^-32
+func EncodeShare(s Point, threshold int, m *big.Int) string {
+	x := new(big.Int).Mod(s.x, m).Bytes()
+	b := binary.AppendUvarint(nil, uint64(len(x)))
+	b = append(b, x...)
+	b = binary.AppendUvarint(b, uint64(threshold))
+	b = binary.BigEndian.AppendUint32(b, ModulusID(m))
+	b = append(b, new(big.Int).Mod(s.y, m).FillBytes(make([]byte, (m.BitLen()+7)/8))...)
+	b = append(b, shareChecksum(b)...)
+	return shareCodePrefix + base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeShare() unpacks a share code made by EncodeShare() for the modulus m and returns the share and the
+// threshold; surrounding white space is ignored
+func DecodeShare(code string, m *big.Int) (Point, int, error) {
+	code = strings.TrimSpace(code)
+	if !strings.HasPrefix(code, shareCodePrefix) {
+		return Point{}, 0, errShareCode
+	}
+	b, err := base64.RawURLEncoding.DecodeString(code[len(shareCodePrefix):])
+	if err != nil || len(b) < 4 {
+		return Point{}, 0, errShareCode
+	}
+	b, sum := b[:len(b)-4], b[len(b)-4:]
+	if !bytes.Equal(sum, shareChecksum(b)) {
+		return Point{}, 0, errShareChecksum
+	}
+	l, n := binary.Uvarint(b)
+	if n <= 0 || l > uint64(len(b)-n) {
+		return Point{}, 0, errShareCode
+	}
+	x, b := new(big.Int).SetBytes(b[n:n+int(l)]), b[n+int(l):]
+	k, n := binary.Uvarint(b)
+	size := (m.BitLen() + 7) / 8
+	if n <= 0 || k > 1<<31-1 || len(b)-n < 4 {
+		return Point{}, 0, errShareCode
+	}
+	b = b[n:]
+	if binary.BigEndian.Uint32(b) != ModulusID(m) {
+		return Point{}, 0, errShareModulus
+	}
+	if len(b) != 4+size {
+		return Point{}, 0, errShareCode
+	}
+	y := new(big.Int).SetBytes(b[4:])
+	if x.Cmp(m) >= 0 || y.Cmp(m) >= 0 {
+		return Point{}, 0, errShareCode
+	}
+	return Point{x, y}, int(k), nil
+}
@@ -0,0 +1,57 @@
+package polynomial
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestShareCode(t *testing.T) {
+	q := new(big.Int).Lsh(big.NewInt(1), 127)
+	q.Sub(q, big.NewInt(1))
+	secret := NewPolyInts(42, 7, 1000)
+	for x := int64(1); x <= 3; x++ {
+		s := NewPoint(big.NewInt(x), secret.Eval(big.NewInt(x), q))
+		code := EncodeShare(s, 3, q)
+		if !strings.HasPrefix(code, "ps1") || strings.ContainsAny(code, "+/=") || len(code) > 40 {
+			t.Errorf("EncodeShare() = %q", code)
+		}
+		got, k, err := DecodeShare(" "+code+"\n", q)
+		if err != nil || k != 3 || got.X().Cmp(s.X()) != 0 || got.Y().Cmp(s.Y()) != 0 {
+			t.Errorf("DecodeShare(%q) = %v, %d, %v, expected %v", code, got, k, err, s)
+		}
+	}
+	// a zero value and index still decode
+	if got, _, err := DecodeShare(EncodeShare(NewPoint(big.NewInt(0), big.NewInt(0)), 1, q), q); err != nil || got.X().Sign() != 0 || got.Y().Sign() != 0 {
+		t.Errorf("DecodeShare() of (0, 0) = %v, %v", got, err)
+	}
+}
+
+func TestShareCodeErrors(t *testing.T) {
+	q := big.NewInt(2147483647)
+	code := EncodeShare(NewPoint(big.NewInt(5), big.NewInt(123456)), 2, q)
+	// flip one character of the body
+	c := []byte(code)
+	if c[6] == 'A' {
+		c[6] = 'B'
+	} else {
+		c[6] = 'A'
+	}
+	cases := []struct {
+		code string
+		m    *big.Int
+		err  error
+	}{
+		{string(c), q, errShareChecksum},
+		{code, big.NewInt(2305843009213693951), errShareModulus},
+		{"xx" + code[2:], q, errShareCode},
+		{code + "!", q, errShareCode},
+		{"ps1", q, errShareCode},
+		{code[:len(code)-4], q, errShareChecksum},
+	}
+	for _, c := range cases {
+		if _, _, err := DecodeShare(c.code, c.m); err != c.err {
+			t.Errorf("DecodeShare(%q) error = %v, expected %v", c.code, err, c.err)
+		}
+	}
+}
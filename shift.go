@@ -0,0 +1,64 @@
+package polynomial
+
+import "math/big"
+
+// MulXn() returns P * x^k by shifting the coefficients (k <= 0 returns a copy)
+func (p Poly) MulXn(k int) Poly {
+	if k < 0 {
+		k = 0
+	}
+	q := p.Clone(0)
+	q.trim()
+	if q.isZero() {
+		return q
+	}
+	return q.Clone(k)
+}
+
+// DivXn() returns (P / x^k, P % x^k), i.e. the coefficients from x^k up shifted down,
+// and the terms of degree lower than k (k <= 0 returns a copy and zero)
+func (p Poly) DivXn(k int) (quo, low Poly) {
+	if k < 0 {
+		k = 0
+	}
+	if k >= len(p) {
+		return NewPolyInts(0), p.Clone(0)
+	}
+	quo = p[k:].Clone(0)
+	quo.trim()
+	if k == 0 {
+		return quo, NewPolyInts(0)
+	}
+	low = p[:k].Clone(0)
+	low.trim()
+	return
+}
+
+// Trunc() returns P mod x^n, i.e. drops every term of degree >= n
+func (p Poly) Trunc(n int) Poly {
+	_, low := p.DivXn(n)
+	low.trim()
+	return low
+}
+
+// EvenOdd() splits P(x) = E(x^2) + x * O(x^2) and returns E and O
+func (p Poly) EvenOdd() (even, odd Poly) {
+	even = make(Poly, (len(p)+1)/2)
+	odd = make(Poly, len(p)/2)
+	for i, c := range p {
+		if i%2 == 0 {
+			even[i/2] = new(big.Int).Set(c)
+		} else {
+			odd[i/2] = new(big.Int).Set(c)
+		}
+	}
+	if len(even) == 0 {
+		even = NewPolyInts(0)
+	}
+	if len(odd) == 0 {
+		odd = NewPolyInts(0)
+	}
+	even.trim()
+	odd.trim()
+	return
+}
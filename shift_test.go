@@ -0,0 +1,82 @@
+package polynomial
+
+import "testing"
+
+func TestMulXn(t *testing.T) {
+	cases := []struct {
+		p   Poly
+		k   int
+		ans Poly
+	}{
+		{NewPolyInts(1, 2), 3, NewPolyInts(0, 0, 0, 1, 2)},
+		{NewPolyInts(1, 2), 0, NewPolyInts(1, 2)},
+		{NewPolyInts(0), 5, NewPolyInts(0)},
+		{NewPolyInts(4, 0, 1), -2, NewPolyInts(4, 0, 1)},
+	}
+	for _, c := range cases {
+		res := c.p.MulXn(c.k)
+		if res.Compare(&c.ans) != 0 {
+			t.Errorf("%v * x^%v != %v (your answer was %v)", c.p, c.k, c.ans, res)
+		}
+	}
+}
+
+func TestDivXn(t *testing.T) {
+	cases := []struct {
+		p        Poly
+		k        int
+		quo, low Poly
+	}{
+		{NewPolyInts(1, 2, 3, 4), 2, NewPolyInts(3, 4), NewPolyInts(1, 2)},
+		{NewPolyInts(1, 0, 0, 4), 2, NewPolyInts(0, 4), NewPolyInts(1)},
+		{NewPolyInts(1, 2, 3), 3, NewPolyInts(0), NewPolyInts(1, 2, 3)},
+		{NewPolyInts(1, 2, 3), 10, NewPolyInts(0), NewPolyInts(1, 2, 3)},
+		{NewPolyInts(1, 2, 3), 0, NewPolyInts(1, 2, 3), NewPolyInts(0)},
+	}
+	for _, c := range cases {
+		quo, low := c.p.DivXn(c.k)
+		if quo.Compare(&c.quo) != 0 || low.Compare(&c.low) != 0 {
+			t.Errorf("%v / x^%v != (%v, %v) (your answer was (%v, %v))", c.p, c.k, c.quo, c.low, quo, low)
+		}
+		back := quo.MulXn(c.k).Add(low, nil)
+		if back.Compare(&c.p) != 0 {
+			t.Errorf("quo * x^%v + low = %v, want %v", c.k, back, c.p)
+		}
+	}
+}
+
+func TestTrunc(t *testing.T) {
+	cases := []struct {
+		p   Poly
+		n   int
+		ans Poly
+	}{
+		{NewPolyInts(1, 2, 3, 4), 2, NewPolyInts(1, 2)},
+		{NewPolyInts(1, 0, 0, 4), 3, NewPolyInts(1)},
+		{NewPolyInts(1, 2, 3), 10, NewPolyInts(1, 2, 3)},
+		{NewPolyInts(1, 2, 3), 0, NewPolyInts(0)},
+	}
+	for _, c := range cases {
+		res := c.p.Trunc(c.n)
+		if res.Compare(&c.ans) != 0 {
+			t.Errorf("%v mod x^%v != %v (your answer was %v)", c.p, c.n, c.ans, res)
+		}
+	}
+}
+
+func TestEvenOdd(t *testing.T) {
+	cases := []struct {
+		p         Poly
+		even, odd Poly
+	}{
+		{NewPolyInts(1, 2, 3, 4, 5), NewPolyInts(1, 3, 5), NewPolyInts(2, 4)},
+		{NewPolyInts(0, 2, 0, 4), NewPolyInts(0), NewPolyInts(2, 4)},
+		{NewPolyInts(7), NewPolyInts(7), NewPolyInts(0)},
+	}
+	for _, c := range cases {
+		even, odd := c.p.EvenOdd()
+		if even.Compare(&c.even) != 0 || odd.Compare(&c.odd) != 0 {
+			t.Errorf("EvenOdd(%v) != (%v, %v) (your answer was (%v, %v))", c.p, c.even, c.odd, even, odd)
+		}
+	}
+}
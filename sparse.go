@@ -0,0 +1,183 @@
+package polynomial
+
+import (
+	"math/big"
+	"sort"
+)
+
+// Term is c * x^Exp
+type Term struct {
+	Exp   int
+	Coeff *big.Int
+}
+
+// Data structure for a sparse polynomial
+// Only the non-zero terms are stored, sorted by increasing exponent
+// f(x) = 3x^1000 + 1 => [{0 1} {1000 3}]
+type SparsePoly []Term
+
+// NewSparsePoly() builds a sparse polynomial from unsorted (possibly repeated) terms
+func NewSparsePoly(terms ...Term) SparsePoly {
+	acc := make(map[int]*big.Int)
+	for _, t := range terms {
+		addTerm(acc, t.Exp, t.Coeff)
+	}
+	return fromTermMap(acc, nil)
+}
+
+func addTerm(acc map[int]*big.Int, e int, c *big.Int) {
+	if v, ok := acc[e]; ok {
+		v.Add(v, c)
+	} else {
+		acc[e] = new(big.Int).Set(c)
+	}
+}
+
+// fromTermMap() drops the zero terms (after reducing modulo m when m is not nil) and sorts the rest
+func fromTermMap(acc map[int]*big.Int, m *big.Int) SparsePoly {
+	s := make(SparsePoly, 0, len(acc))
+	for e, c := range acc {
+		if m != nil {
+			c.Mod(c, m)
+		}
+		if c.Sign() != 0 {
+			s = append(s, Term{e, c})
+		}
+	}
+	sort.Slice(s, func(i, j int) bool { return s[i].Exp < s[j].Exp })
+	return s
+}
+
+// ToSparse() converts a dense polynomial
+func (p Poly) ToSparse() SparsePoly {
+	var s SparsePoly
+	for i, c := range p {
+		if c.Sign() != 0 {
+			s = append(s, Term{i, new(big.Int).Set(c)})
+		}
+	}
+	return s
+}
+
+// ToDense() converts a sparse polynomial
+func (s SparsePoly) ToDense() Poly {
+	p := make(Poly, s.GetDegree()+1)
+	for i := range p {
+		p[i] = big.NewInt(0)
+	}
+	for _, t := range s {
+		p[t.Exp].Set(t.Coeff)
+	}
+	return p
+}
+
+// GetDegree() returns the degree (0 for the zero polynomial, like Poly)
+func (s SparsePoly) GetDegree() int {
+	if len(s) == 0 {
+		return 0
+	}
+	return s[len(s)-1].Exp
+}
+
+// IsDense() reports whether the term count is close enough to the degree
+// that the dense representation is cheaper (see Tuning.SparseDensity)
+func (s SparsePoly) IsDense() bool {
+	d := GetTuning().SparseDensity
+	return d > 0 && float64(len(s)) >= d*float64(s.GetDegree()+1)
+}
+
+func (s SparsePoly) String() string {
+	return s.ToDense().String()
+}
+
+// Add() adds two sparse polynomials
+// modulo m can be nil
+func (s SparsePoly) Add(t SparsePoly, m *big.Int) SparsePoly {
+	acc := make(map[int]*big.Int, len(s)+len(t))
+	for _, a := range s {
+		addTerm(acc, a.Exp, a.Coeff)
+	}
+	for _, b := range t {
+		addTerm(acc, b.Exp, b.Coeff)
+	}
+	return fromTermMap(acc, m)
+}
+
+// Mul() multiplies two sparse polynomials
+// When both operands are dense enough (see Tuning.SparseDensity), the dense multiplication is used
+func (s SparsePoly) Mul(t SparsePoly, m *big.Int) SparsePoly {
+	if s.IsDense() && t.IsDense() {
+		return s.ToDense().Mul(t.ToDense(), m).ToSparse()
+	}
+	acc := make(map[int]*big.Int)
+	c := new(big.Int)
+	for _, a := range s {
+		for _, b := range t {
+			c.Mul(a.Coeff, b.Coeff)
+			addTerm(acc, a.Exp+b.Exp, c)
+		}
+	}
+	return fromTermMap(acc, m)
+}
+
+// Eval() returns s(x), computing only the powers of x that are needed
+func (s SparsePoly) Eval(x, m *big.Int) *big.Int {
+	y := big.NewInt(0)
+	xe := new(big.Int)
+	for _, t := range s {
+		xe.Exp(x, big.NewInt(int64(t.Exp)), m)
+		xe.Mul(xe, t.Coeff)
+		y.Add(y, xe)
+		if m != nil {
+			y.Mod(y, m)
+		}
+	}
+	return y
+}
+
+// Mod() returns the remainder of s divided by q
+// Each step subtracts a shifted copy of q, so sparse moduli such as x^n + 1 keep the work small
+// With m == nil the leading coefficient of q must divide every leading coefficient met, like Div
+func (s SparsePoly) Mod(q SparsePoly, m *big.Int) SparsePoly {
+	if len(q) == 0 {
+		return s
+	}
+	acc := make(map[int]*big.Int, len(s))
+	for _, a := range s {
+		addTerm(acc, a.Exp, a.Coeff)
+	}
+	r := fromTermMap(acc, m)
+	lead := q[len(q)-1]
+	var inv *big.Int
+	if m != nil {
+		inv = new(big.Int).ModInverse(lead.Coeff, m)
+		if inv == nil {
+			return r
+		}
+	}
+	for len(r) > 0 && r.GetDegree() >= lead.Exp {
+		top := r[len(r)-1]
+		f := new(big.Int)
+		if m != nil {
+			f.Mul(top.Coeff, inv)
+			f.Mod(f, m)
+		} else {
+			var rem big.Int
+			f.QuoRem(top.Coeff, lead.Coeff, &rem)
+			if rem.Sign() != 0 {
+				return r
+			}
+		}
+		shift := top.Exp - lead.Exp
+		for _, b := range q {
+			c := new(big.Int).Mul(b.Coeff, f)
+			addTerm(acc, b.Exp+shift, c.Neg(c))
+		}
+		r = fromTermMap(acc, m)
+		acc = make(map[int]*big.Int, len(r))
+		for _, a := range r {
+			acc[a.Exp] = a.Coeff
+		}
+	}
+	return r
+}
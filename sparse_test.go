@@ -0,0 +1,86 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func sparseOf(pairs ...int64) SparsePoly {
+	ts := make([]Term, 0, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		ts = append(ts, Term{int(pairs[i]), big.NewInt(pairs[i+1])})
+	}
+	return NewSparsePoly(ts...)
+}
+
+func sparseEqual(s, t SparsePoly) bool {
+	if len(s) != len(t) {
+		return false
+	}
+	for i := range s {
+		if s[i].Exp != t[i].Exp || s[i].Coeff.Cmp(t[i].Coeff) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSparseConversion(t *testing.T) {
+	p := NewPolyInts(5, 0, 0, -3, 0, 0, 0, 1)
+	s := p.ToSparse()
+	if !sparseEqual(s, sparseOf(0, 5, 3, -3, 7, 1)) {
+		t.Errorf("ToSparse(%v) = %v", p, s)
+	}
+	if d := s.ToDense(); d.Compare(&p) != 0 {
+		t.Errorf("ToDense(ToSparse(%v)) = %v", p, d)
+	}
+	if z := NewSparsePoly(Term{3, big.NewInt(2)}, Term{3, big.NewInt(-2)}); len(z) != 0 || z.String() != "[0]" {
+		t.Errorf("cancelling terms should give the zero polynomial (got %v)", z)
+	}
+}
+
+func TestSparseArithmetic(t *testing.T) {
+	m := big.NewInt(11)
+	cases := []struct {
+		s, t SparsePoly
+		m    *big.Int
+	}{
+		{sparseOf(0, 1, 1000, 3), sparseOf(1000, -3, 2000, 1), nil},
+		{sparseOf(0, 1, 1000, 3), sparseOf(5, 4, 500, 7), m},
+		{sparseOf(0, 1, 1, 2, 2, 3, 3, 4), sparseOf(0, 5, 1, 6, 2, 7), nil},
+		{sparseOf(0, 1, 1, 2, 2, 3, 3, 4), sparseOf(0, 5, 1, 6, 2, 7), m},
+	}
+	for _, c := range cases {
+		ds, dt := c.s.ToDense(), c.t.ToDense()
+		sum := c.s.Add(c.t, c.m).ToDense()
+		ans := ds.Add(dt, c.m)
+		if sum.Compare(&ans) != 0 {
+			t.Errorf("%v + %v != %v (your answer was %v)", c.s, c.t, ans, sum)
+		}
+		prod := c.s.Mul(c.t, c.m).ToDense()
+		ans = ds.Clone(0).Mul(dt.Clone(0), c.m)
+		if prod.Compare(&ans) != 0 {
+			t.Errorf("%v * %v != %v (your answer was %v)", c.s, c.t, ans, prod)
+		}
+		x := big.NewInt(3)
+		if v, w := c.s.Eval(x, c.m), ds.Eval(x, c.m); v.Cmp(w) != 0 {
+			t.Errorf("%v at %v = %v, want %v", c.s, x, v, w)
+		}
+	}
+}
+
+func TestSparseMod(t *testing.T) {
+	m := big.NewInt(17)
+	// x^10 + 2x^3 mod (x^4 + 1) = x^2 * (x^4)^2 + 2x^3 = x^2 + 2x^3
+	s := sparseOf(10, 1, 3, 2)
+	r := s.Mod(sparseOf(4, 1, 0, 1), m)
+	if !sparseEqual(r, sparseOf(2, 1, 3, 2)) {
+		t.Errorf("%v mod (x^4 + 1) = %v", s, r)
+	}
+	// over Z with x^3 - 2
+	s = sparseOf(7, 1, 0, 5)
+	r = s.Mod(sparseOf(3, 1, 0, -2), nil)
+	if !sparseEqual(r, sparseOf(0, 5, 1, 4)) {
+		t.Errorf("%v mod (x^3 - 2) = %v", s, r)
+	}
+}
@@ -0,0 +1,73 @@
+package polynomial
+
+import "math/big"
+
+// Sqrt() returns G with G^2 = P and true if P is a perfect square, or false otherwise
+// Over Z the root has a positive leading coefficient; modulo a prime m it is one of the two roots
+// The coefficients of G are found from the top down, which is Newton iteration on the reversed series,
+// and the candidate is checked by squaring it
+func (p Poly) Sqrt(m *big.Int) (Poly, bool) {
+	p = p.Clone(0)
+	p.sanitize(m)
+	p.trim()
+	if p.isZero() {
+		return NewPolyInts(0), true
+	}
+	d := p.GetDegree()
+	if m != nil && m.Cmp(big.NewInt(2)) == 0 {
+		// in characteristic 2, squaring is additive and the squares are exactly the polynomials in x^2
+		g := make(Poly, d/2+1)
+		for i := range g {
+			g[i] = new(big.Int).Set(p[2*i])
+			if p.Coeff(2*i+1).Sign() != 0 {
+				return nil, false
+			}
+		}
+		return g, true
+	}
+	if d%2 != 0 {
+		return nil, false
+	}
+	n := d / 2
+	g := make(Poly, n+1)
+	if m == nil {
+		if p[d].Sign() < 0 {
+			return nil, false
+		}
+		g[n] = new(big.Int).Sqrt(p[d])
+	} else {
+		g[n] = new(big.Int).ModSqrt(p[d], m)
+		if g[n] == nil {
+			return nil, false
+		}
+	}
+	twoLead := new(big.Int).Lsh(g[n], 1)
+	if m != nil {
+		if twoLead = twoLead.ModInverse(twoLead, m); twoLead == nil {
+			return nil, false
+		}
+	}
+	for k := 1; k <= n; k++ {
+		// p_(2n-k) = 2 g_n g_(n-k) + sum of g_i g_j over n-k < i, j < n with i + j = 2n - k
+		s := new(big.Int).Set(p[2*n-k])
+		for i := n - k + 1; i < n; i++ {
+			if j := 2*n - k - i; j > n-k && j < n {
+				s.Sub(s, new(big.Int).Mul(g[i], g[j]))
+			}
+		}
+		if m == nil {
+			r := new(big.Int)
+			if s.QuoRem(s, twoLead, r); r.Sign() != 0 {
+				return nil, false
+			}
+			g[n-k] = s
+		} else {
+			g[n-k] = s.Mul(s, twoLead).Mod(s, m)
+		}
+	}
+	g.trim()
+	if sq := g.Clone(0).Mul(g.Clone(0), m); !sq.Equal(p) {
+		return nil, false
+	}
+	return g, true
+}
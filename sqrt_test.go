@@ -0,0 +1,51 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSqrt(t *testing.T) {
+	cases := []struct {
+		p    Poly
+		m    *big.Int
+		ok   bool
+		want Poly
+	}{
+		{NewPolyInts(1, 2, 1), nil, true, NewPolyInts(1, 1)},
+		{NewPolyInts(9, -12, 10, -4, 1), nil, true, NewPolyInts(3, -2, 1)},
+		{NewPolyInts(0, 0, 4, 4, 1), nil, true, NewPolyInts(0, 2, 1)},
+		{NewPolyInts(0), nil, true, NewPolyInts(0)},
+		{NewPolyInts(1, 2, 2), nil, false, nil},
+		{NewPolyInts(1, 1, 1), nil, false, nil},
+		{NewPolyInts(1, 0, 0, 1), nil, false, nil},
+		{NewPolyInts(1, 0, -1), nil, false, nil},
+		{NewPolyInts(1, 4, 4), nil, true, NewPolyInts(1, 2)},
+		// the candidate 2x + 1/2 is not an integer polynomial
+		{NewPolyInts(1, 2, 4), nil, false, nil},
+		{NewPolyInts(1, 2, 2), big.NewInt(7), false, nil},
+		// a square without a linear term has a zero constant or leading term
+		{NewPolyInts(2, 0, 9), big.NewInt(17), false, nil},
+		{NewPolyInts(4, 4, 1), big.NewInt(17), true, nil},
+		{NewPolyInts(1, 0, 1), big.NewInt(2), true, NewPolyInts(1, 1)},
+		{NewPolyInts(1, 1, 1), big.NewInt(2), false, nil},
+	}
+	for _, c := range cases {
+		g, ok := c.p.Sqrt(c.m)
+		if ok != c.ok {
+			t.Errorf("Sqrt(%v) mod %v reports %v, want %v", c.p, c.m, ok, c.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		want := c.p.Clone(0)
+		want.sanitize(c.m)
+		if sq := g.Clone(0).Mul(g.Clone(0), c.m); !sq.Equal(want) {
+			t.Errorf("Sqrt(%v) mod %v = %v, whose square is %v", c.p, c.m, g, sq)
+		}
+		if c.want != nil && !g.Equal(c.want) {
+			t.Errorf("Sqrt(%v) mod %v = %v, want %v", c.p, c.m, g, c.want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package polynomial
+
+import "math/big"
+
+// stirlingTable() returns rows 0..n of the signed Stirling numbers of the first kind s(i, k) if first is true,
+// or of the Stirling numbers of the second kind S(i, k) otherwise
+// s(i+1, k) = s(i, k-1) - i s(i, k) and S(i+1, k) = S(i, k-1) + k S(i, k)
+func stirlingTable(n int, first bool) [][]*big.Int {
+	rows := make([][]*big.Int, n+1)
+	rows[0] = []*big.Int{big.NewInt(1)}
+	for i := 0; i < n; i++ {
+		row := make([]*big.Int, i+2)
+		for k := range row {
+			c := new(big.Int)
+			if k <= i {
+				if first {
+					c.Mul(big.NewInt(int64(-i)), rows[i][k])
+				} else {
+					c.Mul(big.NewInt(int64(k)), rows[i][k])
+				}
+			}
+			if k > 0 {
+				c.Add(c, rows[i][k-1])
+			}
+			row[k] = c
+		}
+		rows[i+1] = row
+	}
+	return rows
+}
+
+// StirlingFirst() returns the signed Stirling number of the first kind s(n, k)
+// x(x - 1)...(x - n + 1) = sum s(n, k) x^k
+func StirlingFirst(n, k int) *big.Int {
+	if n < 0 || k < 0 || k > n {
+		return big.NewInt(0)
+	}
+	return stirlingTable(n, true)[n][k]
+}
+
+// StirlingSecond() returns the Stirling number of the second kind S(n, k)
+// x^n = sum S(n, k) x(x - 1)...(x - k + 1)
+func StirlingSecond(n, k int) *big.Int {
+	if n < 0 || k < 0 || k > n {
+		return big.NewInt(0)
+	}
+	return stirlingTable(n, false)[n][k]
+}
+
+// ToFallingFactorial() returns b with P = sum b_k x(x - 1)...(x - k + 1), using S(n, k)
+// modulo m can be nil
+func (p Poly) ToFallingFactorial(m *big.Int) []*big.Int {
+	n := p.GetDegree()
+	table := stirlingTable(n, false)
+	b := make([]*big.Int, n+1)
+	for k := range b {
+		b[k] = new(big.Int)
+	}
+	for i := 0; i <= n; i++ {
+		for k := 0; k <= i; k++ {
+			b[k].Add(b[k], new(big.Int).Mul(p[i], table[i][k]))
+		}
+	}
+	if m != nil {
+		for _, c := range b {
+			c.Mod(c, m)
+		}
+	}
+	return b
+}
+
+// FromFallingFactorial() returns sum b_k x(x - 1)...(x - k + 1), using s(k, i)
+// modulo m can be nil
+func FromFallingFactorial(b []*big.Int, m *big.Int) Poly {
+	if len(b) == 0 {
+		return NewPolyInts(0)
+	}
+	table := stirlingTable(len(b)-1, true)
+	p := make(Poly, len(b))
+	for i := range p {
+		p[i] = new(big.Int)
+	}
+	for k, c := range b {
+		for i := 0; i <= k; i++ {
+			p[i].Add(p[i], new(big.Int).Mul(c, table[k][i]))
+		}
+	}
+	p.sanitize(m)
+	p.trim()
+	return p
+}
@@ -0,0 +1,68 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestStirling(t *testing.T) {
+	cases := []struct {
+		n, k          int
+		first, second int64
+	}{
+		{0, 0, 1, 1},
+		{3, 0, 0, 0},
+		{4, 2, 11, 7},
+		{5, 3, 35, 25},
+		{4, 1, -6, 1},
+		{5, 5, 1, 1},
+		{6, 1, -120, 1},
+		{3, 4, 0, 0},
+	}
+	for _, c := range cases {
+		if got := StirlingFirst(c.n, c.k); got.Int64() != c.first {
+			t.Errorf("StirlingFirst(%d, %d) = %v, want %d", c.n, c.k, got, c.first)
+		}
+		if got := StirlingSecond(c.n, c.k); got.Int64() != c.second {
+			t.Errorf("StirlingSecond(%d, %d) = %v, want %d", c.n, c.k, got, c.second)
+		}
+	}
+}
+
+func TestFallingFactorial(t *testing.T) {
+	cases := []struct {
+		p Poly
+		b []*big.Int
+	}{
+		// x^2 = x(x - 1) + x
+		{NewPolyInts(0, 0, 1), bigs(0, 1, 1)},
+		// x^3 = x(x-1)(x-2) + 3x(x-1) + x
+		{NewPolyInts(0, 0, 0, 1), bigs(0, 1, 3, 1)},
+		{NewPolyInts(7, -2, 0, 5), bigs(7, 3, 15, 5)},
+		{NewPolyInts(4), bigs(4)},
+	}
+	for _, c := range cases {
+		b := c.p.ToFallingFactorial(nil)
+		for k := range c.b {
+			if k >= len(b) || b[k].Cmp(c.b[k]) != 0 {
+				t.Errorf("ToFallingFactorial(%v) = %v, want %v", c.p, b, c.b)
+				break
+			}
+		}
+		if q := FromFallingFactorial(b, nil); q.Compare(&c.p) != 0 {
+			t.Errorf("FromFallingFactorial(%v) = %v, want %v", b, q, c.p)
+		}
+		// the binomial basis differs by k!: d^kP(0) = k! b_k
+		for k, d := range c.p.ForwardDifferences(nil) {
+			if want := new(big.Int).Mul(b[k], new(big.Int).MulRange(1, int64(k))); d.Cmp(want) != 0 {
+				t.Errorf("d^%dP(0) = %v for %v, want %v", k, d, c.p, want)
+			}
+		}
+	}
+
+	m := big.NewInt(11)
+	got := FromFallingFactorial(bigs(0, 0, 0, 1), m)
+	if want := NewPolyInts(0, 2, 8, 1); got.Compare(&want) != 0 {
+		t.Errorf("x(x - 1)(x - 2) mod 11 = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,118 @@
+package polynomial
+
+import "math/big"
+
+// content() returns the positive GCD of the coefficients, or 0 for the zero polynomial
+func (p Poly) content() *big.Int {
+	g := new(big.Int)
+	for _, c := range p {
+		g.GCD(nil, nil, g, new(big.Int).Abs(c))
+	}
+	return g
+}
+
+// primitivePart() divides P by its positive content, which keeps the sign of P everywhere
+func (p Poly) primitivePart() Poly {
+	q := p.Clone(0)
+	q.trim()
+	g := q.content()
+	if g.Sign() == 0 {
+		return q
+	}
+	for _, c := range q {
+		c.Quo(c, g)
+	}
+	return q
+}
+
+// SturmSequence() returns P_0 = P, P_1 = P' and P_(k+1) = -(P_(k-1) mod P_k) until the remainder vanishes
+// Every P_k is scaled by a positive rational to an integer primitive polynomial, which keeps the sign changes
+// The last element is gcd(P, P'); when P has multiple roots every P_k is divided by it, so that the counts
+// stay right at an endpoint that is a multiple root
+func (p Poly) SturmSequence() []Poly {
+	seq := []Poly{p.primitivePart()}
+	if seq[0].GetDegree() < 1 {
+		return seq
+	}
+	seq = append(seq, p.Derivative(nil).primitivePart())
+	for {
+		prev, cur := NewRatPoly(seq[len(seq)-2]), NewRatPoly(seq[len(seq)-1])
+		_, rem := prev.Div(cur)
+		if rem.isZero() {
+			return reduceSturm(seq)
+		}
+		next, _ := rem.Neg().ClearDenominators()
+		seq = append(seq, next.primitivePart())
+	}
+}
+
+// reduceSturm() divides every element of seq by the last one, gcd(P, P'), which is a constant for square-free P
+// The factor is the same for all P_k at any point, so the sign changes are kept away from its roots
+func reduceSturm(seq []Poly) []Poly {
+	g := NewRatPoly(seq[len(seq)-1])
+	if g.GetDegree() < 1 {
+		return seq
+	}
+	for k := range seq {
+		q, _ := NewRatPoly(seq[k]).Div(g)
+		s, _ := q.ClearDenominators()
+		seq[k] = s.primitivePart()
+	}
+	return seq
+}
+
+// signAt() returns the sign of P(x), or the sign at -infinity / +infinity if x is nil and inf is -1 / +1
+func (p Poly) signAt(x *big.Rat, inf int) int {
+	d := p.GetDegree()
+	if x == nil {
+		s := p[d].Sign()
+		if inf < 0 && d%2 == 1 {
+			s = -s
+		}
+		return s
+	}
+	// den^d P(num / den) = sum c_i num^i den^(d-i) has the same sign since den > 0
+	y := new(big.Int)
+	for i := d; i >= 0; i-- {
+		y.Mul(y, x.Num())
+		y.Add(y, new(big.Int).Mul(p[i], new(big.Int).Exp(x.Denom(), big.NewInt(int64(d-i)), nil)))
+	}
+	return y.Sign()
+}
+
+// signVariations() counts the sign changes of the Sturm sequence at x, skipping zeros
+func signVariations(seq []Poly, x *big.Rat, inf int) int {
+	v, last := 0, 0
+	for _, q := range seq {
+		s := q.signAt(x, inf)
+		if s == 0 {
+			continue
+		}
+		if last != 0 && s != last {
+			v++
+		}
+		last = s
+	}
+	return v
+}
+
+// countRoots() returns the number of distinct real roots in (a, b], where nil bounds are infinite
+func countRoots(seq []Poly, a, b *big.Rat) int {
+	return signVariations(seq, a, -1) - signVariations(seq, b, 1)
+}
+
+// CountRealRoots() returns the number of distinct real roots of P in (a, b] (Sturm's theorem)
+// A nil a means -infinity and a nil b means +infinity
+func (p Poly) CountRealRoots(a, b *big.Int) int {
+	if q := p.primitivePart(); q.isZero() {
+		return 0
+	}
+	var ra, rb *big.Rat
+	if a != nil {
+		ra = new(big.Rat).SetInt(a)
+	}
+	if b != nil {
+		rb = new(big.Rat).SetInt(b)
+	}
+	return countRoots(p.SturmSequence(), ra, rb)
+}
@@ -0,0 +1,51 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSturmSequence(t *testing.T) {
+	// x^3 - 3x + 1: P' = 3x^2 - 3 ~ x^2 - 1, -(P mod P') = 2x - 1, -(P' mod (2x - 1)) = 3/4 ~ 1
+	seq := NewPolyInts(1, -3, 0, 1).SturmSequence()
+	want := []Poly{NewPolyInts(1, -3, 0, 1), NewPolyInts(-1, 0, 1), NewPolyInts(-1, 2), NewPolyInts(1)}
+	if len(seq) != len(want) {
+		t.Fatalf("SturmSequence() = %v, want %v", seq, want)
+	}
+	for i := range seq {
+		if seq[i].Compare(&want[i]) != 0 {
+			t.Errorf("SturmSequence()[%d] = %v, want %v", i, seq[i], want[i])
+		}
+	}
+}
+
+func TestCountRealRoots(t *testing.T) {
+	cases := []struct {
+		p    Poly
+		a, b *big.Int
+		want int
+	}{
+		{NewPolyInts(1, -3, 0, 1), nil, nil, 3},
+		{NewPolyInts(1, -3, 0, 1), big.NewInt(0), big.NewInt(1), 1},
+		{NewPolyInts(1, -3, 0, 1), big.NewInt(0), nil, 2},
+		{NewPolyInts(1, 0, 1), nil, nil, 0},
+		// roots 1, 2, 3 and the interval is half-open
+		{Wilkinson(3), big.NewInt(1), big.NewInt(3), 2},
+		{Wilkinson(3), big.NewInt(0), big.NewInt(3), 3},
+		// repeated roots are counted once
+		{NewPolyInts(-1, 1).Mul(NewPolyInts(-1, 1), nil).Mul(NewPolyInts(2, 1), nil), nil, nil, 2},
+		{SwinnertonDyer(2), nil, nil, 4},
+		{SwinnertonDyer(2), big.NewInt(0), big.NewInt(1), 1},
+		{NewPolyInts(5), nil, nil, 0},
+		{NewPolyInts(0), nil, nil, 0},
+		{NewPolyInts(-7, 2), big.NewInt(3), big.NewInt(4), 1},
+		// x^4 - x^2 with the double root 0 as an endpoint: (-2, 0] holds -1 and 0
+		{NewPolyInts(0, 0, -1, 0, 1), big.NewInt(-2), big.NewInt(0), 2},
+		{NewPolyInts(0, 0, -1, 0, 1), big.NewInt(0), big.NewInt(2), 1},
+	}
+	for _, c := range cases {
+		if got := c.p.CountRealRoots(c.a, c.b); got != c.want {
+			t.Errorf("%v has %d real roots in (%v, %v], want %d", c.p, got, c.a, c.b, c.want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package polynomial
+
+import "math/big"
+
+// DerivativeK() returns the k-th derivative in one pass:
+// the coefficient of x^j is P[j+k] * (j+k)(j+k-1)...(j+1)
+// modulo m can be nil
+func (p Poly) DerivativeK(k int, m *big.Int) Poly {
+	if k <= 0 {
+		q := p.Clone(0)
+		q.sanitize(m)
+		return q
+	}
+	if k >= len(p) {
+		return NewPolyInts(0)
+	}
+	q := make(Poly, len(p)-k)
+	f := big.NewInt(1) // (j+k)! / j! for j = 0
+	for i := 1; i <= k; i++ {
+		f.Mul(f, big.NewInt(int64(i)))
+	}
+	for j := range q {
+		q[j] = new(big.Int).Mul(p[j+k], f)
+		// (j+1+k)! / (j+1)! = (j+k)! / j! * (j+1+k) / (j+1), always exact
+		f.Mul(f, big.NewInt(int64(j+1+k)))
+		f.Quo(f, big.NewInt(int64(j+1)))
+	}
+	q.sanitize(m)
+	q.trim()
+	return q
+}
+
+// TaylorAt() returns the coefficients of P(x + a), i.e. the Taylor expansion of P at a:
+// the coefficient of x^k is P^(k)(a) / k!
+// It runs the O(n^2) Horner-style Taylor shift with integer arithmetic only
+func (p Poly) TaylorAt(a, m *big.Int) Poly {
+	q := p.Clone(0)
+	q.sanitize(m)
+	n := len(q)
+	t := new(big.Int)
+	for i := 0; i < n-1; i++ {
+		for j := n - 2; j >= i; j-- {
+			t.Mul(a, q[j+1])
+			q[j].Add(q[j], t)
+			if m != nil {
+				q[j].Mod(q[j], m)
+			}
+		}
+	}
+	q.trim()
+	return q
+}
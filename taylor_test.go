@@ -0,0 +1,59 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDerivativeK(t *testing.T) {
+	p := NewPolyInts(43, 53, 45, 63, 43, 55, 75)
+	for k := 0; k <= 8; k++ {
+		want := p.Clone(0)
+		for i := 0; i < k; i++ {
+			want = want.Derivative(nil)
+		}
+		if res := p.DerivativeK(k, nil); res.Compare(&want) != 0 {
+			t.Errorf("the %v-th derivative of %v is %v (your answer was %v)", k, p, want, res)
+		}
+	}
+	m := big.NewInt(7)
+	// the 7th derivative of x^7 is 7! = 0 mod 7
+	if res := NewPolyInts(0, 0, 0, 0, 0, 0, 0, 1).DerivativeK(7, m); !res.isZero() {
+		t.Errorf("the 7th derivative of x^7 modulo 7 should be 0 (your answer was %v)", res)
+	}
+}
+
+func TestTaylorAt(t *testing.T) {
+	cases := []struct {
+		p   Poly
+		a   *big.Int
+		m   *big.Int
+		ans Poly
+	}{
+		{NewPolyInts(0, 0, 1), big.NewInt(1), nil, NewPolyInts(1, 2, 1)},
+		{NewPolyInts(1, 2, 3), big.NewInt(0), nil, NewPolyInts(1, 2, 3)},
+		{NewPolyInts(0, 0, 0, 1), big.NewInt(-2), nil, NewPolyInts(-8, 12, -6, 1)},
+		{NewPolyInts(0, 0, 0, 1), big.NewInt(-2), big.NewInt(5), NewPolyInts(2, 2, 4, 1)},
+		{NewPolyInts(7), big.NewInt(3), nil, NewPolyInts(7)},
+	}
+	for _, c := range cases {
+		res := c.p.TaylorAt(c.a, c.m)
+		if res.Compare(&c.ans) != 0 {
+			t.Errorf("%v at x + %v != %v (your answer was %v)", c.p, c.a, c.ans, res)
+		}
+	}
+	// the coefficients are the scaled derivatives at a
+	p := NewPolyInts(43, 53, 45, 63, 43, 55, 75)
+	a := big.NewInt(5)
+	q := p.TaylorAt(a, nil)
+	fact := big.NewInt(1)
+	for k := 0; k <= p.GetDegree(); k++ {
+		if k > 0 {
+			fact.Mul(fact, big.NewInt(int64(k)))
+		}
+		d := p.DerivativeK(k, nil).Eval(a, nil)
+		if v := new(big.Int).Mul(q.Coeff(k), fact); v.Cmp(d) != 0 {
+			t.Errorf("%v! * coefficient %v = %v, want P^(%v)(%v) = %v", k, k, v, k, a, d)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Package testvectors generates deterministic suites of inputs and expected outputs of the polynomial package,
+// so that implementations in other languages can check that they compute the same results
+//
+// Integers are decimal strings and polynomials are arrays of them, lowest degree first, with no trailing zeros
+// (the zero polynomial is ["0"]); all arithmetic is modulo the prime given in each case
+package testvectors
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"math/rand/v2"
+
+	"github.com/jongukim/polynomial"
+)
+
+// Version is bumped whenever the same seed would produce different vectors
+const Version = 1
+
+// Case is one vector; which fields are set depends on Op:
+//
+//	add, mul:  A, B and Want = A + B or A * B
+//	div:       A, B and Want = quotient, Rem = remainder, with deg Rem < deg B
+//	gcd:       A, B and Want = the monic gcd
+//	eval:      A, X and Value = A(X)
+//	shares:    A (Value = A(0) is the secret), Threshold = len(A), Shares = (x, A(x)) pairs
+type Case struct {
+	Op        string      `json:"op"`
+	Modulus   string      `json:"modulus"`
+	A         []string    `json:"a"`
+	B         []string    `json:"b,omitempty"`
+	X         string      `json:"x,omitempty"`
+	Want      []string    `json:"want,omitempty"`
+	Rem       []string    `json:"rem,omitempty"`
+	Value     string      `json:"value,omitempty"`
+	Threshold int         `json:"threshold,omitempty"`
+	Shares    [][2]string `json:"shares,omitempty"`
+}
+
+// Suite is the output of Generate()
+type Suite struct {
+	Version int    `json:"version"`
+	Seed    string `json:"seed"`
+	Cases   []Case `json:"cases"`
+}
+
+// Moduli are the primes the vectors are computed with: a 17-bit one that is easy to follow by hand and the
+// Mersenne prime 2^127 - 1, which needs multi-word arithmetic
+var Moduli = []*big.Int{
+	big.NewInt(65537),
+	new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1)),
+}
+
+// generator draws everything from ChaCha8 keyed by SHA-256 of the seed, which is stable across Go releases
+type generator struct {
+	r *rand.ChaCha8
+}
+
+// below() returns a uniform integer in [0, m) by rejection sampling on whole 64-bit words
+func (g *generator) below(m *big.Int) *big.Int {
+	words := (m.BitLen() + 63) / 64
+	excess := uint(words*64 - m.BitLen())
+	for {
+		x := new(big.Int)
+		for i := 0; i < words; i++ {
+			x.Lsh(x, 64).Or(x, new(big.Int).SetUint64(g.r.Uint64()))
+		}
+		if x.Rsh(x, excess); x.Cmp(m) < 0 {
+			return x
+		}
+	}
+}
+
+// intn() returns a uniform integer in [0, n)
+func (g *generator) intn(n int) int {
+	return int(g.below(big.NewInt(int64(n))).Int64())
+}
+
+// poly() returns a random polynomial of degree exactly d modulo m
+func (g *generator) poly(d int, m *big.Int) polynomial.Poly {
+	p := make(polynomial.Poly, d+1)
+	for i := range p {
+		p[i] = g.below(m)
+	}
+	for p[d].Sign() == 0 {
+		p[d] = g.below(m)
+	}
+	return p
+}
+
+// encode() writes P as decimal strings
+func encode(p polynomial.Poly) []string {
+	s := make([]string, len(p))
+	for i, c := range p {
+		s[i] = c.String()
+	}
+	return s
+}
+
+// Generate() returns perOp cases of every operation for every modulus, determined by the seed alone
+func Generate(seed []byte, perOp int) *Suite {
+	key := sha256.Sum256(seed)
+	g := &generator{rand.NewChaCha8(key)}
+	s := &Suite{Version: Version, Seed: hex.EncodeToString(seed)}
+	for _, m := range Moduli {
+		for i := 0; i < perOp; i++ {
+			s.Cases = append(s.Cases, g.cases(m)...)
+		}
+	}
+	return s
+}
+
+// cases() returns one case of every operation modulo m
+func (g *generator) cases(m *big.Int) []Case {
+	mod := m.String()
+	a, b := g.poly(g.intn(12), m), g.poly(g.intn(12), m)
+	cs := []Case{
+		{Op: "add", Modulus: mod, A: encode(a), B: encode(b), Want: encode(a.Add(b, m))},
+		{Op: "mul", Modulus: mod, A: encode(a), B: encode(b), Want: encode(a.Clone(0).Mul(b.Clone(0), m))},
+	}
+	quo, rem := a.Clone(0).Div(b.Clone(0), m)
+	cs = append(cs, Case{Op: "div", Modulus: mod, A: encode(a), B: encode(b), Want: encode(quo), Rem: encode(rem)})
+	// a common factor makes the gcd non-trivial
+	c := g.poly(1+g.intn(4), m)
+	ga, gb := a.Clone(0).Mul(c.Clone(0), m), b.Clone(0).Mul(c.Clone(0), m)
+	gcd, _ := ga.Clone(0).Gcd(gb.Clone(0), m).Monic(m)
+	cs = append(cs, Case{Op: "gcd", Modulus: mod, A: encode(ga), B: encode(gb), Want: encode(gcd)})
+	x := g.below(m)
+	cs = append(cs, Case{Op: "eval", Modulus: mod, A: encode(a), X: x.String(), Value: a.Eval(x, m).String()})
+	k := 1 + g.intn(5)
+	secret := g.poly(k-1, m)
+	share := Case{Op: "shares", Modulus: mod, A: encode(secret), Value: secret.ConstantTerm().String(), Threshold: k}
+	for j := 1; j <= k+2; j++ {
+		xj := big.NewInt(int64(j))
+		share.Shares = append(share.Shares, [2]string{xj.String(), secret.Eval(xj, m).String()})
+	}
+	return append(cs, share)
+}
@@ -0,0 +1,89 @@
+package testvectors
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/jongukim/polynomial"
+)
+
+func decode(t *testing.T, s []string) polynomial.Poly {
+	p := make(polynomial.Poly, len(s))
+	for i, c := range s {
+		var ok bool
+		if p[i], ok = new(big.Int).SetString(c, 10); !ok {
+			t.Fatalf("bad integer %q", c)
+		}
+	}
+	if len(p) == 0 || len(p) > 1 && p[len(p)-1].Sign() == 0 {
+		t.Errorf("polynomial %v is not trimmed", s)
+	}
+	return p
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	a, _ := json.Marshal(Generate([]byte("seed"), 3))
+	b, _ := json.Marshal(Generate([]byte("seed"), 3))
+	c, _ := json.Marshal(Generate([]byte("other seed"), 3))
+	if string(a) != string(b) {
+		t.Errorf("the same seed gave different suites")
+	}
+	if string(a) == string(c) {
+		t.Errorf("different seeds gave the same suite")
+	}
+}
+
+func TestGenerateConsistent(t *testing.T) {
+	s := Generate([]byte("check"), 5)
+	if s.Version != Version || len(s.Cases) != 5*6*len(Moduli) {
+		t.Fatalf("suite has version %d and %d cases", s.Version, len(s.Cases))
+	}
+	for _, c := range s.Cases {
+		m, _ := new(big.Int).SetString(c.Modulus, 10)
+		a := decode(t, c.A)
+		switch c.Op {
+		case "add", "mul", "gcd":
+			b, want := decode(t, c.B), decode(t, c.Want)
+			switch c.Op {
+			case "add":
+				if !want.Sub(b, m).Equal(a) {
+					t.Errorf("add: %v - %v != %v", want, b, a)
+				}
+			case "mul":
+				if _, r := want.Clone(0).Div(b.Clone(0), m); !r.Equal(polynomial.NewPolyInts(0)) {
+					t.Errorf("mul: %v does not divide %v", b, want)
+				}
+			case "gcd":
+				_, ra := a.Clone(0).Div(want.Clone(0), m)
+				_, rb := b.Clone(0).Div(want.Clone(0), m)
+				zero := polynomial.NewPolyInts(0)
+				if !want.IsMonic(m) || want.GetDegree() < 1 || !ra.Equal(zero) || !rb.Equal(zero) {
+					t.Errorf("gcd: %v is not a monic common divisor of %v and %v", want, a, b)
+				}
+			}
+		case "div":
+			b, q, r := decode(t, c.B), decode(t, c.Want), decode(t, c.Rem)
+			if got := q.Clone(0).Mul(b.Clone(0), m).Add(r, m); !got.Equal(a) || r.GetDegree() >= b.GetDegree() && b.GetDegree() > 0 {
+				t.Errorf("div: %v * %v + %v != %v", q, b, r, a)
+			}
+		case "eval":
+			x, _ := new(big.Int).SetString(c.X, 10)
+			if a.Eval(x, m).String() != c.Value {
+				t.Errorf("eval: %v(%v) != %v", a, x, c.Value)
+			}
+		case "shares":
+			var ps polynomial.Points
+			for _, sh := range c.Shares[len(c.Shares)-c.Threshold:] {
+				x, _ := new(big.Int).SetString(sh[0], 10)
+				y, _ := new(big.Int).SetString(sh[1], 10)
+				ps = append(ps, polynomial.NewPoint(x, y))
+			}
+			if got := ps.Lagrange(m).Eval(big.NewInt(0), m); got.String() != c.Value {
+				t.Errorf("shares: recovered %v, expected %v", got, c.Value)
+			}
+		default:
+			t.Errorf("unknown op %q", c.Op)
+		}
+	}
+}
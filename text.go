@@ -0,0 +1,197 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+var errParse = errors.New("polynomial: malformed polynomial text")
+
+// parseExpPerByte bounds exponents by the input length, since x^e allocates e + 1 coefficients: a short config
+// value cannot force gigabytes, while sparse moduli such as x^65536 + 1 still parse
+const parseExpPerByte = 1 << 13
+
+// polyParser reads terms c x^e separated by + and - from a string; white space is ignored
+// With cas set it also accepts the computer algebra forms c*x, x**e and x^(e), and the variable can be any name
+type polyParser struct {
+	s   string
+	pos int
+	x   string
+	cas bool
+}
+
+// peek() returns the next non-space byte, or 0 at the end
+func (r *polyParser) peek() byte {
+	for r.pos < len(r.s) && strings.IndexByte(" \t\r\n", r.s[r.pos]) >= 0 {
+		r.pos++
+	}
+	if r.pos == len(r.s) {
+		return 0
+	}
+	return r.s[r.pos]
+}
+
+// digits() reads a non-empty run of decimal digits
+func (r *polyParser) digits() (string, bool) {
+	r.peek()
+	start := r.pos
+	for r.pos < len(r.s) && r.s[r.pos] >= '0' && r.s[r.pos] <= '9' {
+		r.pos++
+	}
+	return r.s[start:r.pos], r.pos > start
+}
+
+// isNameByte() checks whether b can continue a variable name
+func isNameByte(b byte) bool {
+	return b == '_' || b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+// variable() consumes the variable if it comes next as a whole name
+func (r *polyParser) variable() bool {
+	r.peek()
+	end := r.pos + len(r.x)
+	if !strings.HasPrefix(r.s[r.pos:], r.x) || end < len(r.s) && isNameByte(r.s[end]) {
+		return false
+	}
+	r.pos = end
+	return true
+}
+
+// exponent() reads the exponent after a power operator, in parentheses in the CAS forms
+func (r *polyParser) exponent() (int, bool) {
+	paren := r.cas && r.peek() == '('
+	if paren {
+		r.pos++
+	}
+	d, ok := r.digits()
+	if !ok || len(d) > 9 {
+		return 0, false
+	}
+	if paren {
+		if r.peek() != ')' {
+			return 0, false
+		}
+		r.pos++
+	}
+	e := 0
+	for _, b := range d {
+		e = 10*e + int(b-'0')
+	}
+	if e > parseExpPerByte*len(r.s) {
+		return 0, false
+	}
+	return e, true
+}
+
+// term() reads one unsigned term and returns its coefficient and exponent
+func (r *polyParser) term() (*big.Int, int, bool) {
+	c := big.NewInt(1)
+	d, ok := r.digits()
+	if ok {
+		c.SetString(d, 10)
+		if r.cas && r.peek() == '*' && !strings.HasPrefix(r.s[r.pos:], "**") {
+			r.pos++
+			if !r.variable() {
+				return nil, 0, false
+			}
+		} else if !r.variable() {
+			return c, 0, true
+		}
+	} else if !r.variable() {
+		return nil, 0, false
+	}
+	switch {
+	case r.peek() == '^':
+		r.pos++
+	case r.cas && strings.HasPrefix(r.s[r.pos:], "**"):
+		r.pos += 2
+	default:
+		return c, 1, true
+	}
+	e, ok := r.exponent()
+	return c, e, ok
+}
+
+// ParsePoly() reads a polynomial in the form String() prints, such as "3x^2 - x + 7", with or without the
+// surrounding brackets; terms may come in any order and repeated powers are added up
+func ParsePoly(s string) (Poly, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		s = s[1 : len(s)-1]
+	}
+	return (&polyParser{s: s, x: "x"}).parse()
+}
+
+// ParseCAS() reads a polynomial in the variable x (x if empty) as Mathematica or Maple print it: the terms of
+// ParsePoly() plus "2*x" for products, "x**3" or "x^(10)" for powers, and line breaks, as in "x^3 + 2*x - 5",
+// "-5 + 2 x + x^3" or "x**3+2*x-5"
+func ParseCAS(s, x string) (Poly, error) {
+	if x == "" {
+		x = "x"
+	}
+	for i := 0; i < len(x); i++ {
+		if !isNameByte(x[i]) || i == 0 && x[i] >= '0' && x[i] <= '9' {
+			return nil, errParse
+		}
+	}
+	// Mathematica breaks long output lines with a backslash
+	s = strings.ReplaceAll(s, "\\\n", "")
+	return (&polyParser{s: s, x: x, cas: true}).parse()
+}
+
+// parse() reads a whole sum of terms
+func (r *polyParser) parse() (Poly, error) {
+	p := NewPolyInts(0)
+	for first := true; first || r.peek() != 0; first = false {
+		neg := false
+		switch r.peek() {
+		case '-':
+			neg = true
+			r.pos++
+		case '+':
+			if first {
+				return nil, errParse
+			}
+			r.pos++
+		default:
+			if !first {
+				return nil, errParse
+			}
+		}
+		c, e, ok := r.term()
+		if !ok {
+			return nil, errParse
+		}
+		if neg {
+			c.Neg(c)
+		}
+		for len(p) <= e {
+			p = append(p, big.NewInt(0))
+		}
+		p[e].Add(p[e], c)
+	}
+	p.trim()
+	return p, nil
+}
+
+// MarshalText() returns P in the form "3x^2 - x + 7" (String() without the brackets)
+func (p Poly) MarshalText() ([]byte, error) {
+	q := p
+	if len(p) > 0 {
+		q = p.Clone(0)
+		q.trim()
+	}
+	s := q.String()
+	return []byte(s[1 : len(s)-1]), nil
+}
+
+// UnmarshalText() parses the output of MarshalText() back with ParsePoly()
+func (p *Poly) UnmarshalText(text []byte) error {
+	q, err := ParsePoly(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
@@ -0,0 +1,111 @@
+package polynomial
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestParsePoly(t *testing.T) {
+	cases := []struct {
+		s    string
+		want Poly
+	}{
+		{"3x^2 - x + 7", NewPolyInts(7, -1, 3)},
+		{"[3x^2 - x + 7]", NewPolyInts(7, -1, 3)},
+		{"-x^3+2", NewPolyInts(2, 0, 0, -1)},
+		{"  7 + x + x ", NewPolyInts(7, 2)},
+		{"x - x", NewPolyInts(0)},
+		{"0", NewPolyInts(0)},
+		{"[0]", NewPolyInts(0)},
+		// -(2^80 + 3)
+		{"-1208925819614629174706179x", Poly{big.NewInt(0), new(big.Int).Neg(new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 80), big.NewInt(3)))}},
+	}
+	for _, c := range cases {
+		if got, err := ParsePoly(c.s); err != nil || !got.Equal(c.want) {
+			t.Errorf("ParsePoly(%q) = %v, %v, expected %v", c.s, got, err, c.want)
+		}
+	}
+	for _, s := range []string{"", "+x", "x +", "3 x^", "x^-1", "2y", "x x", "3 - - 2", "x^1234567890", "x^999999999", "[x"} {
+		if _, err := ParsePoly(s); err != errParse {
+			t.Errorf("ParsePoly(%q) error = %v", s, err)
+		}
+	}
+	// the exponent bound still admits sparse ring moduli
+	if p, err := ParsePoly("x^65536 + 1"); err != nil || p.GetDegree() != 65536 {
+		t.Errorf("ParsePoly(\"x^65536 + 1\") = degree %v, %v", p.GetDegree(), err)
+	}
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(1), 64)
+	huge.Add(huge, big.NewInt(1))
+	cases := []Poly{
+		NewPolyInts(7, -1, 3),
+		NewPolyInts(0),
+		NewPolyInts(-1, 1, -1, 0, 1),
+		// 2^64 + 1 must not print as a bare x
+		{big.NewInt(0), huge, new(big.Int).Neg(huge)},
+		RandomPoly(12, 200),
+	}
+	for _, p := range cases {
+		text, err := p.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var q Poly
+		if err := q.UnmarshalText(text); err != nil || !q.Equal(p) {
+			t.Errorf("UnmarshalText(%q) = %v, %v, expected %v", text, q, err, p)
+		}
+	}
+	var config struct{ Generator Poly }
+	if err := json.Unmarshal([]byte(`{"Generator": "x^2 + x + 1"}`), &config); err != nil || !config.Generator.Equal(NewPolyInts(1, 1, 1)) {
+		t.Errorf("json.Unmarshal() = %v, %v", config.Generator, err)
+	}
+	if b, _ := json.Marshal(config); string(b) != `{"Generator":"x^2 + x + 1"}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+	// a zero-value field must not panic
+	if b, err := json.Marshal(struct{ Generator Poly }{}); err != nil || string(b) != `{"Generator":"0"}` {
+		t.Errorf("json.Marshal() of a nil Poly = %s, %v", b, err)
+	}
+}
+
+func TestParseCAS(t *testing.T) {
+	cases := []struct {
+		s, x string
+		want Poly
+	}{
+		{"x^3 + 2*x - 5", "", NewPolyInts(-5, 2, 0, 1)},
+		{"x**3+2*x-5", "x", NewPolyInts(-5, 2, 0, 1)},
+		// Mathematica orders terms by increasing degree and multiplies by juxtaposition
+		{"-5 + 2 x + x^3", "", NewPolyInts(-5, 2, 0, 1)},
+		{"t^(10) - 3*t", "t", NewPolyInts(0, -3).Add(NewPolyInts(1).Clone(10), nil)},
+		{"4*z1**2 +\n  z1 - 1", "z1", NewPolyInts(-1, 1, 4)},
+		{"1 + 2 x + \\\n3 x^2", "", NewPolyInts(1, 2, 3)},
+		{"3x^2 - x + 7", "", NewPolyInts(7, -1, 3)},
+	}
+	for _, c := range cases {
+		if got, err := ParseCAS(c.s, c.x); err != nil || !got.Equal(c.want) {
+			t.Errorf("ParseCAS(%q, %q) = %v, %v, expected %v", c.s, c.x, got, err, c.want)
+		}
+	}
+	errs := []struct{ s, x string }{
+		{"2*y", "x"},
+		{"x*", ""},
+		{"2**x", ""},
+		{"x^(3", ""},
+		{"1/2*x", ""},
+		{"xx + 1", ""},
+		{"x + 1", "1x"},
+		{"x + 1", "x-y"},
+	}
+	for _, c := range errs {
+		if _, err := ParseCAS(c.s, c.x); err != errParse {
+			t.Errorf("ParseCAS(%q, %q) error = %v", c.s, c.x, err)
+		}
+	}
+	if _, err := ParsePoly("x**2"); err != errParse {
+		t.Errorf("ParsePoly() accepted the ** form")
+	}
+}
@@ -0,0 +1,125 @@
+package polynomial
+
+import "math/big"
+
+// toeplitzMatrix() returns the n x n matrix with T[i][j] = col[i-j] for i >= j and row[j-i] otherwise
+func toeplitzMatrix(col, row []*big.Int) [][]*big.Int {
+	n := len(col)
+	t := make([][]*big.Int, n)
+	for i := range t {
+		t[i] = make([]*big.Int, n)
+		for j := range t[i] {
+			if i >= j {
+				t[i][j] = col[i-j]
+			} else {
+				t[i][j] = row[j-i]
+			}
+		}
+	}
+	return t
+}
+
+// SolveToeplitz() returns x with T x = b modulo a prime m, for the Toeplitz matrix with first column col and
+// first row row (col[0] = row[0])
+// Levinson's recursion grows the solution and the forward and backward vectors f, b with T_k f = e_0 and
+// T_k b = e_(k-1) one dimension at a time in O(n^2); when a leading principal minor vanishes it falls back to
+// SolveLinearSystem()
+func SolveToeplitz(col, row, b []*big.Int, m *big.Int) ([]*big.Int, error) {
+	if m == nil || m.Sign() <= 0 {
+		return nil, errBadModulus
+	}
+	n := len(col)
+	if len(row) != n || len(b) != n {
+		return nil, errMatrixShape
+	}
+	if n > 0 {
+		if d := new(big.Int).Sub(col[0], row[0]); d.Mod(d, m).Sign() != 0 {
+			return nil, errMatrixShape
+		}
+	}
+	if x, ok := levinson(col, row, b, m); ok {
+		return x, nil
+	}
+	return SolveLinearSystem(toeplitzMatrix(col, row), b, m)
+}
+
+// levinson() is the O(n^2) part of SolveToeplitz(); ok is false when a leading principal minor is zero
+func levinson(col, row, y []*big.Int, m *big.Int) (x []*big.Int, ok bool) {
+	n := len(col)
+	if n == 0 {
+		return nil, true
+	}
+	// t(k) = T[i][j] for k = i - j
+	t := func(k int) *big.Int {
+		if k >= 0 {
+			return col[k]
+		}
+		return row[-k]
+	}
+	inv := new(big.Int).ModInverse(new(big.Int).Mod(t(0), m), m)
+	if inv == nil {
+		return nil, false
+	}
+	f := []*big.Int{inv}
+	bw := []*big.Int{new(big.Int).Set(inv)}
+	x = []*big.Int{new(big.Int).Mul(y[0], inv)}
+	x[0].Mod(x[0], m)
+	tmp := new(big.Int)
+	for k := 1; k < n; k++ {
+		ef, eb, ex := new(big.Int), new(big.Int), new(big.Int)
+		for i := 0; i < k; i++ {
+			ef.Add(ef, tmp.Mul(t(k-i), f[i]))
+			eb.Add(eb, tmp.Mul(t(-(i+1)), bw[i]))
+			ex.Add(ex, tmp.Mul(t(k-i), x[i]))
+		}
+		ef.Mod(ef, m)
+		eb.Mod(eb, m)
+		d := new(big.Int).Mul(ef, eb)
+		d.Sub(big.NewInt(1), d).Mod(d, m)
+		if d.ModInverse(d, m) == nil {
+			return nil, false
+		}
+		// f' = ([f; 0] - ef [0; b]) / d and b' = ([0; b] - eb [f; 0]) / d
+		nf, nb := make([]*big.Int, k+1), make([]*big.Int, k+1)
+		for i := 0; i <= k; i++ {
+			fi, bi := new(big.Int), new(big.Int)
+			if i < k {
+				fi.Set(f[i])
+			}
+			if i > 0 {
+				bi.Set(bw[i-1])
+			}
+			nf[i] = new(big.Int).Sub(fi, tmp.Mul(ef, bi))
+			nf[i].Mul(nf[i], d).Mod(nf[i], m)
+			nb[i] = new(big.Int).Sub(bi, tmp.Mul(eb, fi))
+			nb[i].Mul(nb[i], d).Mod(nb[i], m)
+		}
+		f, bw = nf, nb
+		// x' = [x; 0] + (y_k - ex) b'
+		ex.Sub(y[k], ex).Mod(ex, m)
+		x = append(x, new(big.Int))
+		for i := range x {
+			x[i].Add(x[i], tmp.Mul(ex, bw[i])).Mod(x[i], m)
+		}
+	}
+	return x, true
+}
+
+// SolveHankel() returns x with H x = b modulo a prime m for the Hankel matrix H[i][j] = h[i+j], len(h) = 2n - 1
+// Reversing the rows of H gives the Toeplitz matrix J H, so it is solved as J H x = J b by SolveToeplitz()
+func SolveHankel(h, b []*big.Int, m *big.Int) ([]*big.Int, error) {
+	n := len(b)
+	if n == 0 && len(h) == 0 {
+		return nil, nil
+	}
+	if len(h) != 2*n-1 {
+		return nil, errMatrixShape
+	}
+	col, row, jb := make([]*big.Int, n), make([]*big.Int, n), make([]*big.Int, n)
+	for i := 0; i < n; i++ {
+		col[i] = h[n-1-i]
+		row[i] = h[n-1+i]
+		jb[i] = b[n-1-i]
+	}
+	return SolveToeplitz(col, row, jb, m)
+}
@@ -0,0 +1,65 @@
+package polynomial
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestSolveToeplitz(t *testing.T) {
+	m := big.NewInt(1000003)
+	cases := []struct {
+		col, row, b []*big.Int
+	}{
+		{bigs(4), bigs(4), bigs(8)},
+		{bigs(2, 1, 0), bigs(2, 3, 5), bigs(1, 2, 3)},
+		// T[0][0] = 0 stops Levinson's recursion and falls back to elimination
+		{bigs(0, 1, 2), bigs(0, 3, 4), bigs(5, 6, 7)},
+		// the 2 x 2 leading minor 1 - 1 vanishes
+		{bigs(1, 1, 3), bigs(1, 1, 2), bigs(1, 0, 0)},
+	}
+	rr := rand.New(rand.NewSource(5))
+	for n := 4; n <= 20; n += 8 {
+		c := struct{ col, row, b []*big.Int }{make([]*big.Int, n), make([]*big.Int, n), make([]*big.Int, n)}
+		for i := 0; i < n; i++ {
+			c.col[i], c.row[i], c.b[i] = big.NewInt(rr.Int63n(1000)), big.NewInt(rr.Int63n(1000)), big.NewInt(rr.Int63n(1000))
+		}
+		c.row[0] = c.col[0]
+		cases = append(cases, c)
+	}
+	for _, c := range cases {
+		want, err := SolveLinearSystem(toeplitzMatrix(c.col, c.row), c.b, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := SolveToeplitz(c.col, c.row, c.b, m)
+		if err != nil || !equalVectors(got, want) {
+			t.Errorf("SolveToeplitz(%v, %v, %v) = %v, %v, expected %v", c.col, c.row, c.b, got, err, want)
+		}
+	}
+
+	if _, err := SolveToeplitz(bigs(1, 2), bigs(3, 2), bigs(1, 1), m); err != errMatrixShape {
+		t.Errorf("col[0] != row[0]: error %v, expected errMatrixShape", err)
+	}
+	if _, err := SolveToeplitz(bigs(1, 1), bigs(1, 1), bigs(1, 1), m); err != errSingular {
+		t.Errorf("singular Toeplitz system: error %v, expected errSingular", err)
+	}
+}
+
+func TestSolveHankel(t *testing.T) {
+	m := big.NewInt(101)
+	// H = [[1, 2, 4], [2, 4, 3], [4, 3, 5]]
+	h := bigs(1, 2, 4, 3, 5)
+	b := bigs(1, 1, 2)
+	hm := [][]*big.Int{h[0:3], h[1:4], h[2:5]}
+	want, err := SolveLinearSystem(hm, b, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := SolveHankel(h, b, m); err != nil || !equalVectors(got, want) {
+		t.Errorf("SolveHankel() = %v, %v, expected %v", got, err, want)
+	}
+	if _, err := SolveHankel(h[:4], b, m); err != errMatrixShape {
+		t.Errorf("short h: error %v, expected errMatrixShape", err)
+	}
+}
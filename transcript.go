@@ -0,0 +1,103 @@
+package polynomial
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/big"
+)
+
+// Transcript implements Fiat-Shamir challenge derivation
+// Every message is absorbed with a label, and challenges depend on everything absorbed so far
+type Transcript struct {
+	h     hash.Hash
+	state []byte
+}
+
+// appendLenBytes() appends a 4-byte big-endian length followed by b
+func appendLenBytes(buf, b []byte) []byte {
+	var l [4]byte
+	binary.BigEndian.PutUint32(l[:], uint32(len(b)))
+	buf = append(buf, l[:]...)
+	return append(buf, b...)
+}
+
+// appendBigInt() appends a sign byte and the length-prefixed magnitude of v
+func appendBigInt(buf []byte, v *big.Int) []byte {
+	buf = append(buf, byte(v.Sign()+1))
+	return appendLenBytes(buf, v.Bytes())
+}
+
+// NewTranscript() starts a transcript for the protocol named by label
+// h is reset before every use and must not be shared with other goroutines
+func NewTranscript(label string, h hash.Hash) *Transcript {
+	t := &Transcript{h: h}
+	t.AppendBytes("protocol", []byte(label))
+	return t
+}
+
+// AppendBytes() absorbs an arbitrary message such as a marshaled commitment
+func (t *Transcript) AppendBytes(label string, msg []byte) {
+	t.h.Reset()
+	t.h.Write(t.state)
+	buf := appendLenBytes(nil, []byte(label))
+	buf = appendLenBytes(buf, msg)
+	t.h.Write(buf)
+	t.state = t.h.Sum(t.state[:0])
+}
+
+// AppendInt() absorbs a big integer
+func (t *Transcript) AppendInt(label string, v *big.Int) {
+	t.AppendBytes(label, appendBigInt(nil, v))
+}
+
+// AppendPoly() absorbs a polynomial (its degree followed by every coefficient)
+// P is trimmed first, so leading zeros do not change the transcript
+func (t *Transcript) AppendPoly(label string, p Poly) {
+	q := NewPolyInts(0)
+	if len(p) > 0 {
+		q = p.Clone(0)
+		q.trim()
+	}
+	var buf []byte
+	buf = appendBigInt(buf, big.NewInt(int64(q.GetDegree())))
+	for _, c := range q {
+		buf = appendBigInt(buf, c)
+	}
+	t.AppendBytes(label, buf)
+}
+
+// Challenge() squeezes a uniformly distributed element of [0, q)
+// Candidates of q.BitLen() bits are rejected until one is smaller than q, so there is no modulo bias
+// The challenge is absorbed back, so two consecutive calls give different values
+// q must be positive (q = 1 always gives 0)
+func (t *Transcript) Challenge(label string, q *big.Int) *big.Int {
+	if q.Sign() <= 0 {
+		panic("polynomial: the challenge range must be positive")
+	}
+	bits := q.BitLen()
+	nb := (bits + 7) / 8
+	mask := byte(0xff >> uint(8*nb-bits))
+	c := new(big.Int)
+	var ctr uint32
+	for {
+		var out []byte
+		for len(out) < nb {
+			t.h.Reset()
+			t.h.Write(t.state)
+			t.h.Write(appendLenBytes(nil, []byte(label)))
+			var cb [4]byte
+			binary.BigEndian.PutUint32(cb[:], ctr)
+			t.h.Write(cb[:])
+			out = t.h.Sum(out)
+			ctr++
+		}
+		out = out[:nb]
+		out[0] &= mask
+		c.SetBytes(out)
+		if c.Cmp(q) < 0 {
+			break
+		}
+	}
+	t.AppendInt(label, c)
+	return c
+}
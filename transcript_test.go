@@ -0,0 +1,63 @@
+package polynomial
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+func TestTranscript(t *testing.T) {
+	q := big.NewInt(179424691)
+	mk := func() *Transcript {
+		tr := NewTranscript("test", sha256.New())
+		tr.AppendPoly("p", NewPolyInts(1, 2, 3))
+		tr.AppendInt("x", big.NewInt(-5))
+		tr.AppendBytes("com", []byte{1, 2, 3})
+		return tr
+	}
+	a, b := mk(), mk()
+	ca, cb := a.Challenge("beta", q), b.Challenge("beta", q)
+	if ca.Cmp(cb) != 0 {
+		t.Errorf("the same transcript gives different challenges (%v, %v)", ca, cb)
+	}
+	if ca.Cmp(q) >= 0 || ca.Sign() < 0 {
+		t.Errorf("challenge %v is out of [0, %v)", ca, q)
+	}
+	if next := a.Challenge("beta", q); next.Cmp(ca) == 0 {
+		t.Errorf("two consecutive challenges should differ (%v)", next)
+	}
+
+	c := NewTranscript("test", sha256.New())
+	c.AppendPoly("p", NewPolyInts(1, 2, 4))
+	c.AppendInt("x", big.NewInt(-5))
+	c.AppendBytes("com", []byte{1, 2, 3})
+	if cc := c.Challenge("beta", q); cc.Cmp(cb) == 0 {
+		t.Errorf("a different polynomial gives the same challenge %v", cc)
+	}
+
+	// a modulus just above a power of two rejects about half of the candidates
+	small := big.NewInt(17)
+	tr := NewTranscript("range", sha256.New())
+	seen := make(map[int64]bool)
+	for i := 0; i < 500; i++ {
+		v := tr.Challenge("c", small)
+		if v.Cmp(small) >= 0 {
+			t.Fatalf("challenge %v is out of [0, %v)", v, small)
+		}
+		seen[v.Int64()] = true
+	}
+	if len(seen) != 17 {
+		t.Errorf("500 challenges mod 17 should cover every residue (got %v)", len(seen))
+	}
+	if v := tr.Challenge("c", big.NewInt(1)); v.Sign() != 0 {
+		t.Errorf("a challenge in [0, 1) is %v", v)
+	}
+
+	// leading zeros do not change the transcript
+	d, e := NewTranscript("test", sha256.New()), NewTranscript("test", sha256.New())
+	d.AppendPoly("p", NewPolyInts(1))
+	e.AppendPoly("p", Poly{big.NewInt(1), big.NewInt(0)})
+	if cd, ce := d.Challenge("beta", q), e.Challenge("beta", q); cd.Cmp(ce) != 0 {
+		t.Errorf("[1] and [1, 0] give different challenges (%v, %v)", cd, ce)
+	}
+}
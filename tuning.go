@@ -0,0 +1,106 @@
+package polynomial
+
+import (
+	"math/big"
+	"sync/atomic"
+	"time"
+)
+
+// Tuning holds the thresholds that pick an algorithm
+// KaratsubaThreshold: Mul uses Karatsuba when both operands have at least this many coefficients (0 disables it)
+// ParallelThreshold: Karatsuba computes its three sub-products concurrently from this many coefficients (0 disables it)
+// NTTThreshold: Mul modulo a prime with a large enough power-of-two root of unity (such as 998244353) uses the
+// NTT when both operands have at least this many coefficients (0 disables it; SelfTune keeps the default)
+// NewtonDivThreshold: Quo and Rem use Newton inversion when the divisor and the quotient have at least this many
+// coefficients (0 disables it; SelfTune keeps the default)
+// EagerReduction: Mul and Eval reduce after every product instead of deferring the reductions
+// SparseDensity: the fraction of non-zero terms above which the SparsePoly operations switch to the dense
+// algorithms (0 disables it)
+type Tuning struct {
+	KaratsubaThreshold int
+	ParallelThreshold  int
+	NTTThreshold       int
+	NewtonDivThreshold int
+	EagerReduction     bool
+	SparseDensity      float64
+}
+
+// DefaultTuning is used until SetTuning() or SelfTune() is called
+var DefaultTuning = Tuning{KaratsubaThreshold: 32, ParallelThreshold: 1024, NTTThreshold: 256, NewtonDivThreshold: 128, SparseDensity: 0.25}
+
+var tuning atomic.Value
+
+func init() {
+	tuning.Store(DefaultTuning)
+}
+
+// GetTuning() returns the thresholds in use
+func GetTuning() Tuning {
+	return tuning.Load().(Tuning)
+}
+
+// SetTuning() replaces the thresholds for every later operation
+// It is safe to call concurrently with polynomial arithmetic
+func SetTuning(t Tuning) {
+	tuning.Store(t)
+}
+
+// useNTT() checks the size condition of NTTThreshold; the modulus is checked by nttPlan()
+func useNTT(la, lb int, m *big.Int, t Tuning) bool {
+	return m != nil && t.NTTThreshold > 0 && la >= t.NTTThreshold && lb >= t.NTTThreshold
+}
+
+// timeMul() returns the best of a few runs of P * Q with the given thresholds
+func timeMul(p, q Poly, m *big.Int, t Tuning) time.Duration {
+	best := time.Duration(1<<63 - 1)
+	for run := 0; run < 3; run++ {
+		start := time.Now()
+		karatsuba(p, q, m, t)
+		if d := time.Since(start); d < best {
+			best = d
+		}
+	}
+	return best
+}
+
+// SelfTune() benchmarks Mul on this machine with random coefficients modulo an nb-byte modulus,
+// installs the thresholds with SetTuning() and returns them
+// It takes a fraction of a second for small moduli; the crossover points move with the coefficient size
+func SelfTune(nb int) Tuning {
+	m := RandomBigInt(nb)
+	m.SetBit(m, 8*nb-1, 1)
+	random := func(n int) Poly {
+		p := make(Poly, n)
+		for i := range p {
+			p[i] = new(big.Int).Mod(RandomBigInt(nb), m)
+		}
+		return p
+	}
+	t := DefaultTuning
+	// the smallest size where one Karatsuba level beats schoolbook (0 if none up to 256)
+	t.KaratsubaThreshold = 0
+	for n := 8; n <= 256; n *= 2 {
+		p, q := random(n), random(n)
+		school := Tuning{KaratsubaThreshold: n + 1}
+		level := Tuning{KaratsubaThreshold: n}
+		if timeMul(p, q, m, level) < timeMul(p, q, m, school) {
+			t.KaratsubaThreshold = n
+			break
+		}
+	}
+	// the smallest size where spawning goroutines pays off
+	t.ParallelThreshold = 0
+	if t.KaratsubaThreshold > 0 {
+		for n := 4 * t.KaratsubaThreshold; n <= 4096; n *= 2 {
+			p, q := random(n), random(n)
+			seq := Tuning{KaratsubaThreshold: t.KaratsubaThreshold}
+			par := Tuning{KaratsubaThreshold: t.KaratsubaThreshold, ParallelThreshold: n}
+			if timeMul(p, q, m, par) < timeMul(p, q, m, seq) {
+				t.ParallelThreshold = n
+				break
+			}
+		}
+	}
+	SetTuning(t)
+	return t
+}
@@ -0,0 +1,56 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestTuning(t *testing.T) {
+	saved := GetTuning()
+	defer SetTuning(saved)
+
+	p, q := RandomPoly(200, 128), RandomPoly(150, 128)
+	// 998244353 = 119 * 2^23 + 1 has NTT roots, 10^9 + 7 does not and falls back
+	for _, m := range []*big.Int{big.NewInt(998244353), big.NewInt(1000000007)} {
+		var results []Poly
+		for _, tu := range []Tuning{{}, {KaratsubaThreshold: 16}, {KaratsubaThreshold: 2, ParallelThreshold: 32}, {NTTThreshold: 16}} {
+			SetTuning(tu)
+			if got := GetTuning(); got != tu {
+				t.Errorf("GetTuning() = %+v, want %+v", got, tu)
+			}
+			results = append(results, p.Clone(0).Mul(q.Clone(0), m))
+		}
+		for _, r := range results[1:] {
+			if r.Compare(&results[0]) != 0 {
+				t.Errorf("Mul() modulo %v depends on the tuning", m)
+			}
+		}
+	}
+
+	// SparseDensity picks the algorithm of SparsePoly.Mul but not the result
+	s := sparseOf(0, 1, 1, 2, 2, 3, 3, 4)
+	SetTuning(Tuning{SparseDensity: 0.25})
+	dense := s.Mul(s, nil)
+	if !s.IsDense() {
+		t.Errorf("%v should be dense with SparseDensity 0.25", s)
+	}
+	SetTuning(Tuning{})
+	if s.IsDense() || !sparseEqual(s.Mul(s, nil), dense) {
+		t.Errorf("SparseDensity 0 should keep the sparse algorithms with the same result")
+	}
+}
+
+func TestSelfTune(t *testing.T) {
+	if testing.Short() {
+		t.Skip("SelfTune() runs benchmarks")
+	}
+	saved := GetTuning()
+	defer SetTuning(saved)
+	tu := SelfTune(8)
+	if tu != GetTuning() {
+		t.Errorf("SelfTune() = %+v was not installed (%+v)", tu, GetTuning())
+	}
+	if tu.KaratsubaThreshold < 0 || tu.ParallelThreshold < 0 {
+		t.Errorf("SelfTune() = %+v", tu)
+	}
+}
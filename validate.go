@@ -0,0 +1,42 @@
+package polynomial
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var errEmptyPoly = errors.New("polynomial: the polynomial has no coefficient (use NewPolyInts(0) for zero)")
+
+// Validate() reports the first malformation that makes the other methods panic or misbehave:
+// no coefficient at all, a nil coefficient, or a zero leading coefficient (untrimmed)
+func (p Poly) Validate() error {
+	if len(p) == 0 {
+		return errEmptyPoly
+	}
+	for i, c := range p {
+		if c == nil {
+			return fmt.Errorf("polynomial: coefficient %d is nil", i)
+		}
+	}
+	if d := p.GetDegree(); d > 0 && p[d].Sign() == 0 {
+		return fmt.Errorf("polynomial: the leading coefficient of degree %d is zero (not trimmed)", d)
+	}
+	return nil
+}
+
+// Normalize() fixes a malformed polynomial in place: nils become zeros, an empty polynomial
+// becomes 0, the coefficients are reduced modulo m (if m is not nil) and P is trimmed
+func (p *Poly) Normalize(m *big.Int) {
+	if len(*p) == 0 {
+		*p = NewPolyInts(0)
+		return
+	}
+	for i, c := range *p {
+		if c == nil {
+			(*p)[i] = big.NewInt(0)
+		}
+	}
+	p.sanitize(m)
+	p.trim()
+}
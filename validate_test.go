@@ -0,0 +1,35 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestValidateAndNormalize(t *testing.T) {
+	cases := []struct {
+		p     Poly
+		m     *big.Int
+		valid bool
+		ans   Poly
+	}{
+		{NewPolyInts(1, 2, 3), nil, true, NewPolyInts(1, 2, 3)},
+		{NewPolyInts(0), nil, true, NewPolyInts(0)},
+		{Poly{}, nil, false, NewPolyInts(0)},
+		{Poly{big.NewInt(1), nil, big.NewInt(3)}, nil, false, NewPolyInts(1, 0, 3)},
+		{Poly{big.NewInt(1), big.NewInt(2), big.NewInt(0)}, nil, false, NewPolyInts(1, 2)},
+		{Poly{nil, nil}, nil, false, NewPolyInts(0)},
+		{Poly{big.NewInt(12), nil, big.NewInt(22)}, big.NewInt(11), false, NewPolyInts(1)},
+	}
+	for _, c := range cases {
+		if err := c.p.Validate(); (err == nil) != c.valid {
+			t.Errorf("Validate(%#v) returns %v", c.p, err)
+		}
+		c.p.Normalize(c.m)
+		if err := c.p.Validate(); err != nil {
+			t.Errorf("a normalized polynomial should be valid (got %v)", err)
+		}
+		if c.p.Compare(&c.ans) != 0 {
+			t.Errorf("Normalize() gives %v, want %v", c.p, c.ans)
+		}
+	}
+}
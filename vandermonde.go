@@ -0,0 +1,77 @@
+package polynomial
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errSingular = errors.New("polynomial: the matrix is singular modulo m")
+
+// BuildVandermonde() returns the n x n matrix V with V[i][j] = xs[i]^j
+// modulo m can be nil
+func BuildVandermonde(xs []*big.Int, m *big.Int) [][]*big.Int {
+	v := make([][]*big.Int, len(xs))
+	for i, x := range xs {
+		v[i] = make([]*big.Int, len(xs))
+		pow := big.NewInt(1)
+		for j := range v[i] {
+			v[i][j] = new(big.Int).Set(pow)
+			if m != nil {
+				v[i][j].Mod(v[i][j], m)
+			}
+			pow.Mul(pow, x)
+			if m != nil {
+				pow.Mod(pow, m)
+			}
+		}
+	}
+	return v
+}
+
+// SolveVandermonde() returns c with V c = ys for V = BuildVandermonde(xs), i.e. the coefficients of the polynomial
+// of degree < n through (xs[i], ys[i]), modulo a prime m in O(n^2)
+// With Z(x) = (x - xs[0])...(x - xs[n-1]), c = sum ys[j] Z(x) / ((x - xs[j]) Z'(xs[j])), and each quotient is a
+// synthetic division, so no matrix is formed
+func SolveVandermonde(xs, ys []*big.Int, m *big.Int) ([]*big.Int, error) {
+	if m == nil || m.Sign() <= 0 {
+		return nil, errBadModulus
+	}
+	if len(xs) != len(ys) {
+		return nil, errMatrixShape
+	}
+	n := len(xs)
+	if n == 0 {
+		return nil, nil
+	}
+	ps := make([]Poly, n)
+	for i, x := range xs {
+		ps[i] = xMinusConst(x)
+	}
+	z := product(ps, m).Clone(0)
+	dz := z.Derivative(m)
+	c := make([]*big.Int, n)
+	for i := range c {
+		c[i] = new(big.Int)
+	}
+	q := make([]*big.Int, n)
+	for i := range q {
+		q[i] = new(big.Int)
+	}
+	w, t := new(big.Int), new(big.Int)
+	for j, x := range xs {
+		// Z'(x_j) = 0 exactly when x_j is repeated
+		if w.ModInverse(dz.Eval(x, m), m) == nil {
+			return nil, errSingular
+		}
+		w.Mul(w, ys[j]).Mod(w, m)
+		// Z / (x - x_j) from the top: q_(n-1) = 1, q_(k-1) = z_k + x_j q_k
+		q[n-1].SetInt64(1)
+		for k := n - 1; k > 0; k-- {
+			q[k-1].Mul(x, q[k]).Add(q[k-1], z[k]).Mod(q[k-1], m)
+		}
+		for k := range c {
+			c[k].Add(c[k], t.Mul(w, q[k])).Mod(c[k], m)
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,72 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBuildVandermonde(t *testing.T) {
+	v := BuildVandermonde([]*big.Int{big.NewInt(2), big.NewInt(3), big.NewInt(-1)}, big.NewInt(5))
+	want := [][]int64{{1, 2, 4}, {1, 3, 4}, {1, 4, 1}}
+	for i := range want {
+		for j := range want[i] {
+			if v[i][j].Int64() != want[i][j] {
+				t.Errorf("V[%d][%d] = %v, expected %d", i, j, v[i][j], want[i][j])
+			}
+		}
+	}
+}
+
+func TestSolveVandermonde(t *testing.T) {
+	m := big.NewInt(998244353)
+	cases := []struct {
+		xs, ys []int64
+		m      *big.Int
+		want   []int64
+	}{
+		// 1 + 2x + 3x^2 through x = 0, 1, 2
+		{[]int64{0, 1, 2}, []int64{1, 6, 17}, m, []int64{1, 2, 3}},
+		{[]int64{5}, []int64{7}, m, []int64{7}},
+		// 3 + x^2 mod 7 at 1, 2, 3, 4
+		{[]int64{1, 2, 3, 4}, []int64{4, 0, 5, 5}, big.NewInt(7), []int64{3, 0, 1, 0}},
+	}
+	for _, c := range cases {
+		xs, ys := make([]*big.Int, len(c.xs)), make([]*big.Int, len(c.ys))
+		for i := range c.xs {
+			xs[i], ys[i] = big.NewInt(c.xs[i]), big.NewInt(c.ys[i])
+		}
+		got, err := SolveVandermonde(xs, ys, c.m)
+		if err != nil {
+			t.Errorf("SolveVandermonde(%v, %v): %v", c.xs, c.ys, err)
+			continue
+		}
+		for i := range c.want {
+			if got[i].Int64() != c.want[i] {
+				t.Errorf("SolveVandermonde(%v, %v) = %v, expected %v", c.xs, c.ys, got, c.want)
+				break
+			}
+		}
+		// V c = y
+		v := BuildVandermonde(xs, c.m)
+		for i := range v {
+			s := new(big.Int)
+			for j := range v[i] {
+				s.Add(s, new(big.Int).Mul(v[i][j], got[j]))
+			}
+			if s.Mod(s, c.m).Cmp(new(big.Int).Mod(ys[i], c.m)) != 0 {
+				t.Errorf("row %d of V c is %v, expected %v", i, s, ys[i])
+			}
+		}
+	}
+
+	dup := []*big.Int{big.NewInt(1), big.NewInt(8)}
+	if _, err := SolveVandermonde(dup, dup, big.NewInt(7)); err != errSingular {
+		t.Errorf("repeated points: error %v, expected errSingular", err)
+	}
+	if _, err := SolveVandermonde(dup, dup[:1], m); err != errMatrixShape {
+		t.Errorf("mismatched lengths: error %v, expected errMatrixShape", err)
+	}
+	if _, err := SolveVandermonde(dup, dup, nil); err != errBadModulus {
+		t.Errorf("no modulus: error %v, expected errBadModulus", err)
+	}
+}
@@ -0,0 +1,87 @@
+package polynomial
+
+import "math/big"
+
+// BlackBox is a linear operator given only by its product with a vector, e.g. a huge sparse matrix
+// It must return a new slice and may leave its entries unreduced
+type BlackBox func(v []*big.Int) []*big.Int
+
+// reduced() wraps the black box so that its results are reduced modulo m
+func (a BlackBox) reduced(m *big.Int) func([]*big.Int) []*big.Int {
+	return func(v []*big.Int) []*big.Int {
+		w := a(v)
+		for _, c := range w {
+			c.Mod(c, m)
+		}
+		return w
+	}
+}
+
+// MinPoly() returns the minimal polynomial of the n x n black box modulo a prime m with O(n) products per round
+// (see MinPoly for matrices, which shares the algorithm and its error bound)
+func (a BlackBox) MinPoly(n int, m *big.Int) Poly {
+	return minPolyOp(a.reduced(m), n, m)
+}
+
+// Solve() returns x with A x = b for a non-singular n x n black box modulo a prime m (Wiedemann's algorithm)
+// With F(x) = f_0 + f_1 x + ... the minimal polynomial of the Krylov sequence A^i b, F(A) b = 0 gives
+// x = -(f_1 b + f_2 A b + ... + f_d A^(d-1) b) / f_0; F is the lcm of Berlekamp-Massey runs on random projections
+// u . A^i b until A x = b holds; f_0 = 0 shows that A is singular, although a consistent system with a singular
+// A is still solved when the Krylov space of b avoids the kernel
+func (a BlackBox) Solve(n int, b []*big.Int, m *big.Int) ([]*big.Int, error) {
+	if m == nil || m.Sign() <= 0 {
+		return nil, errBadModulus
+	}
+	if len(b) != n {
+		return nil, errMatrixShape
+	}
+	apply := a.reduced(m)
+	rb := make([]*big.Int, n)
+	for i, c := range b {
+		rb[i] = new(big.Int).Mod(c, m)
+	}
+	if isZeroVector(rb) {
+		return rb, nil
+	}
+	f := NewPolyInts(1)
+	for f.GetDegree() < n {
+		u := randomVector(n, m)
+		seq := make([]*big.Int, 2*n)
+		v := rb
+		for i := range seq {
+			seq[i] = dot(u, v, m)
+			v = apply(v)
+		}
+		g := BerlekampMassey(seq, m)
+		f = normalQuo(f.Mul(g.Clone(0), m), normalGcd(f, g, m), m)
+		if f[0].Sign() == 0 {
+			return nil, errSingular
+		}
+		x := wiedemannCandidate(f, apply, rb, m)
+		if equalVectors(apply(x), rb) {
+			return x, nil
+		}
+	}
+	return nil, errSingular
+}
+
+// wiedemannCandidate() returns -(f_1 b + f_2 A b + ... + f_d A^(d-1) b) / f_0
+func wiedemannCandidate(f Poly, apply func([]*big.Int) []*big.Int, b []*big.Int, m *big.Int) []*big.Int {
+	x := applyPoly(f[1:], apply, b, m)
+	c := new(big.Int).ModInverse(f[0], m)
+	c.Neg(c)
+	for _, e := range x {
+		e.Mul(e, c).Mod(e, m)
+	}
+	return x
+}
+
+// equalVectors() compares two reduced vectors entry by entry
+func equalVectors(u, v []*big.Int) bool {
+	for i := range u {
+		if u[i].Cmp(v[i]) != 0 {
+			return false
+		}
+	}
+	return len(u) == len(v)
+}
@@ -0,0 +1,78 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+// tridiagonal() returns the black box of the n x n matrix with 2 on the diagonal and -1 beside it
+func tridiagonal(n int) BlackBox {
+	return func(v []*big.Int) []*big.Int {
+		w := make([]*big.Int, n)
+		for i := range w {
+			w[i] = new(big.Int).Lsh(v[i], 1)
+			if i > 0 {
+				w[i].Sub(w[i], v[i-1])
+			}
+			if i+1 < n {
+				w[i].Sub(w[i], v[i+1])
+			}
+		}
+		return w
+	}
+}
+
+func TestBlackBoxSolve(t *testing.T) {
+	m := big.NewInt(1000003)
+	for _, n := range []int{1, 2, 10, 60} {
+		a := tridiagonal(n)
+		b := make([]*big.Int, n)
+		for i := range b {
+			b[i] = big.NewInt(int64(i*i - 7))
+		}
+		x, err := a.Solve(n, b, m)
+		if err != nil {
+			t.Fatalf("Solve() of size %d: %v", n, err)
+		}
+		ax := a.reduced(m)(x)
+		for i := range b {
+			if ax[i].Cmp(new(big.Int).Mod(b[i], m)) != 0 {
+				t.Fatalf("Solve() of size %d: (A x)[%d] = %v, expected %v", n, i, ax[i], b[i])
+			}
+		}
+	}
+
+	// the all-ones 3 x 3 matrix has rank 1
+	ones := BlackBox(func(v []*big.Int) []*big.Int {
+		s := new(big.Int)
+		for _, c := range v {
+			s.Add(s, c)
+		}
+		return []*big.Int{new(big.Int).Set(s), new(big.Int).Set(s), new(big.Int).Set(s)}
+	})
+	b := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+	if _, err := ones.Solve(3, b, m); err != errSingular {
+		t.Errorf("singular Solve(): error %v, expected errSingular", err)
+	}
+	// b = (3, 3, 3) is an eigenvector for 3, so its Krylov space avoids the kernel and x = b / 3 is found
+	b3 := []*big.Int{big.NewInt(3), big.NewInt(3), big.NewInt(3)}
+	if x, err := ones.Solve(3, b3, m); err != nil || x[0].Int64() != 1 || x[1].Int64() != 1 || x[2].Int64() != 1 {
+		t.Errorf("consistent singular Solve() = %v, %v, expected (1, 1, 1)", x, err)
+	}
+	if _, err := ones.Solve(2, b, m); err != errMatrixShape {
+		t.Errorf("Solve() with a wrong size: error %v, expected errMatrixShape", err)
+	}
+	// x^2 - 3x
+	if got := ones.MinPoly(3, m); !got.Equal(NewPolyInts(0, 1000000, 1)) {
+		t.Errorf("MinPoly() of the all-ones matrix = %v", got)
+	}
+}
+
+func TestBlackBoxMinPoly(t *testing.T) {
+	m := big.NewInt(101)
+	a := intMatrix([]int64{2, 1, 0, 0}, []int64{0, 2, 0, 0}, []int64{0, 0, 5, 0}, []int64{0, 0, 0, 5})
+	box := BlackBox(func(v []*big.Int) []*big.Int { return matVec(a, v, nil) })
+	if got, want := box.MinPoly(4, m), MinPoly(a, m); !got.Equal(want) {
+		t.Errorf("BlackBox.MinPoly() = %v, MinPoly() = %v", got, want)
+	}
+}
@@ -0,0 +1,125 @@
+package polynomial
+
+import "math/big"
+
+// Workspace owns temporaries for hot loops, so that MulTo, DivTo and EvalTo stop allocating once the
+// destination buffers and the workspace have grown to the sizes in use
+// A Workspace is not safe for concurrent use; give every goroutine its own
+type Workspace struct {
+	t, q, r *big.Int
+	// inv = lead^-1 mod mod, cached for repeated divisions by the same divisor
+	lead, mod, inv *big.Int
+}
+
+// NewWorkspace() returns an empty workspace
+func NewWorkspace() *Workspace {
+	return &Workspace{new(big.Int), new(big.Int), new(big.Int), new(big.Int), new(big.Int), new(big.Int)}
+}
+
+// resize() returns dst with n zero coefficients, reusing its big.Int values and capacity
+func resize(dst Poly, n int) Poly {
+	if cap(dst) < n {
+		grown := make(Poly, n)
+		copy(grown, dst[:cap(dst)])
+		dst = grown
+	}
+	dst = dst[:n]
+	for i, c := range dst {
+		if c == nil {
+			dst[i] = new(big.Int)
+		} else {
+			c.SetInt64(0)
+		}
+	}
+	return dst
+}
+
+// reduce() sets z to z mod m in [0, m) without allocating a quotient
+func (w *Workspace) reduce(z, m *big.Int) {
+	w.q.QuoRem(z, m, w.r)
+	if w.r.Sign() < 0 {
+		w.r.Add(w.r, m)
+	}
+	z.Set(w.r)
+}
+
+// MulTo() stores P * Q in dst and returns it; dst must not share coefficients with P or Q
+// Products are accumulated and every coefficient is reduced once at the end
+// modulo m can be nil
+func (w *Workspace) MulTo(dst, p, q Poly, m *big.Int) Poly {
+	dst = resize(dst, len(p)+len(q)-1)
+	for i, a := range p {
+		for j, b := range q {
+			dst[i+j].Add(dst[i+j], w.t.Mul(a, b))
+		}
+	}
+	if m != nil {
+		for _, c := range dst {
+			w.reduce(c, m)
+		}
+	}
+	dst.trim()
+	return dst
+}
+
+// DivTo() stores P / Q and P % Q modulo a prime m in quo and rem and returns them
+// quo and rem must not share coefficients with P, Q or each other
+func (w *Workspace) DivTo(quo, rem, p, q Poly, m *big.Int) (Poly, Poly) {
+	dq := q.GetDegree()
+	for dq > 0 && q[dq].Sign() == 0 {
+		dq--
+	}
+	rem = resize(rem, len(p))
+	for i, c := range p {
+		w.reduce(rem[i].Set(c), m)
+	}
+	rem.trim()
+	if rem.GetDegree() < dq {
+		return resize(quo, 1), rem
+	}
+	// like Div, division by zero returns (0, P)
+	if w.reduce(w.t.Set(q[dq]), m); w.t.Sign() == 0 {
+		return resize(quo, 1), rem
+	}
+	if w.t.Cmp(w.lead) != 0 || m.Cmp(w.mod) != 0 {
+		w.lead.Set(w.t)
+		w.mod.Set(m)
+		// like Div, a leading coefficient without an inverse modulo a composite m returns (0, P)
+		if w.inv.ModInverse(w.lead, m) == nil {
+			w.lead.SetInt64(0)
+			return resize(quo, 1), rem
+		}
+	}
+	quo = resize(quo, rem.GetDegree()-dq+1)
+	for k := rem.GetDegree() - dq; k >= 0; k-- {
+		c := quo[k]
+		c.Mul(rem[k+dq], w.inv)
+		w.reduce(c, m)
+		if c.Sign() == 0 {
+			continue
+		}
+		for i := 0; i <= dq; i++ {
+			rem[k+i].Sub(rem[k+i], w.t.Mul(c, q[i]))
+			w.reduce(rem[k+i], m)
+		}
+	}
+	rem = rem[:len(rem)-len(quo)]
+	if len(rem) == 0 {
+		rem = resize(rem, 1)
+	}
+	rem.trim()
+	return quo, rem
+}
+
+// EvalTo() stores P(x) in dst and returns it (Horner's method)
+// modulo m can be nil
+func (w *Workspace) EvalTo(dst *big.Int, p Poly, x, m *big.Int) *big.Int {
+	dst.SetInt64(0)
+	for i := len(p) - 1; i >= 0; i-- {
+		dst.Add(w.t.Mul(dst, x), p[i])
+		if m != nil {
+			w.reduce(dst, m)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,70 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestWorkspace(t *testing.T) {
+	m := big.NewInt(998244353)
+	w := NewWorkspace()
+	var prod, quo, rem Poly
+	y := new(big.Int)
+	cases := []struct {
+		p, q Poly
+		m    *big.Int
+	}{
+		{RandomPoly(20, 60), RandomPoly(7, 60), m},
+		{NewPolyInts(-3, 0, 2), NewPolyInts(5, -1), nil},
+		{RandomPoly(3, 60), RandomPoly(9, 60), m},
+		{RandomPoly(12, 60), NewPolyInts(7), m},
+		{NewPolyInts(0), NewPolyInts(1, 1), m},
+		{NewPolyInts(4, 5), NewPolyInts(0), m},
+	}
+	for _, c := range cases {
+		want := c.p.Clone(0).Mul(c.q.Clone(0), c.m)
+		if prod = w.MulTo(prod, c.p, c.q, c.m); prod.Compare(&want) != 0 {
+			t.Errorf("MulTo(%v, %v) = %v, want %v", c.p, c.q, prod, want)
+		}
+		x := big.NewInt(12345)
+		if y = w.EvalTo(y, c.p, x, c.m); y.Cmp(c.p.Eval(x, c.m)) != 0 {
+			t.Errorf("EvalTo(%v, %v) = %v, want %v", c.p, x, y, c.p.Eval(x, c.m))
+		}
+		if c.m == nil {
+			continue
+		}
+		wq, wr := c.p.Clone(0).Div(c.q.Clone(0), c.m)
+		if quo, rem = w.DivTo(quo, rem, c.p, c.q, c.m); quo.Compare(&wq) != 0 || rem.Compare(&wr) != 0 {
+			t.Errorf("DivTo(%v, %v) = %v, %v, want %v, %v", c.p, c.q, quo, rem, wq, wr)
+		}
+	}
+	// 3 has no inverse modulo 15, and the inverse of 2 cached by the first division must not be reused
+	m = big.NewInt(15)
+	p := NewPolyInts(4, 1, 7, 2)
+	for _, q := range []Poly{NewPolyInts(1, 2), NewPolyInts(1, 3), NewPolyInts(1, 3)} {
+		wq, wr := p.Clone(0).Div(q.Clone(0), m)
+		if quo, rem = w.DivTo(quo, rem, p, q, m); quo.Compare(&wq) != 0 || rem.Compare(&wr) != 0 {
+			t.Errorf("DivTo(%v, %v) mod 15 = %v, %v, want %v, %v", p, q, quo, rem, wq, wr)
+		}
+	}
+}
+
+func TestWorkspaceAllocs(t *testing.T) {
+	m := big.NewInt(998244353)
+	w := NewWorkspace()
+	p, q := RandomPoly(64, 60), RandomPoly(31, 60)
+	p.sanitize(m)
+	q.sanitize(m)
+	var prod, quo, rem Poly
+	y := new(big.Int)
+	x := big.NewInt(777)
+	run := func() {
+		prod = w.MulTo(prod, p, q, m)
+		quo, rem = w.DivTo(quo, rem, prod, q, m)
+		y = w.EvalTo(y, p, x, m)
+	}
+	run()
+	if n := testing.AllocsPerRun(20, run); n > 0 {
+		t.Errorf("a warm workspace allocates %v times per run", n)
+	}
+}
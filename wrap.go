@@ -0,0 +1,37 @@
+package polynomial
+
+import "math/big"
+
+// reduceWrap() folds every block of n coefficients onto the first one, negating odd blocks when sign < 0
+func reduceWrap(p Poly, n, sign int, m *big.Int) Poly {
+	if n < 1 {
+		panic("polynomial: the wrap-around length must be positive")
+	}
+	r := make(Poly, n)
+	for i := range r {
+		r[i] = new(big.Int)
+	}
+	for i, c := range p {
+		if sign < 0 && (i/n)%2 == 1 {
+			r[i%n].Sub(r[i%n], c)
+		} else {
+			r[i%n].Add(r[i%n], c)
+		}
+	}
+	r.sanitize(m)
+	r.trim()
+	return r
+}
+
+// ReduceCyclic() returns P mod (x^n - 1) in O(deg P) by adding the coefficient of x^i to that of x^(i mod n)
+// modulo m can be nil
+func ReduceCyclic(p Poly, n int, m *big.Int) Poly {
+	return reduceWrap(p, n, 1, m)
+}
+
+// ReduceNegacyclic() returns P mod (x^n + 1) in O(deg P); x^n = -1, so the coefficient of x^(kn+i) is added to
+// that of x^i with the sign (-1)^k
+// modulo m can be nil
+func ReduceNegacyclic(p Poly, n int, m *big.Int) Poly {
+	return reduceWrap(p, n, -1, m)
+}
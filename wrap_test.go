@@ -0,0 +1,48 @@
+package polynomial
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestReduceWrap(t *testing.T) {
+	cases := []struct {
+		p                  Poly
+		n                  int
+		m                  *big.Int
+		cyclic, negacyclic Poly
+	}{
+		// 1 + 2x + 3x^2 + 4x^3 + 5x^4 with x^2 = 1 and x^2 = -1
+		{NewPolyInts(1, 2, 3, 4, 5), 2, nil, NewPolyInts(9, 6), NewPolyInts(3, -2)},
+		{NewPolyInts(1, 2, 3, 4, 5), 2, big.NewInt(7), NewPolyInts(2, 6), NewPolyInts(3, 5)},
+		{NewPolyInts(1, 2), 4, nil, NewPolyInts(1, 2), NewPolyInts(1, 2)},
+		{NewPolyInts(0, 0, 0, 1), 3, nil, NewPolyInts(1), NewPolyInts(-1)},
+		{NewPolyInts(1, 0, 0, 0, 0, 0, 1), 3, nil, NewPolyInts(2), NewPolyInts(2)},
+	}
+	for _, c := range cases {
+		if got := ReduceCyclic(c.p, c.n, c.m); !got.Equal(c.cyclic) {
+			t.Errorf("ReduceCyclic(%v, %d) = %v, expected %v", c.p, c.n, got, c.cyclic)
+		}
+		if got := ReduceNegacyclic(c.p, c.n, c.m); !got.Equal(c.negacyclic) {
+			t.Errorf("ReduceNegacyclic(%v, %d) = %v, expected %v", c.p, c.n, got, c.negacyclic)
+		}
+	}
+
+	m := big.NewInt(998244353)
+	p := RandomPoly(300, 40)
+	for _, n := range []int{1, 16, 64} {
+		xn := make(Poly, n+1)
+		for i := range xn {
+			xn[i] = new(big.Int)
+		}
+		xn[n].SetInt64(1)
+		xn[0].SetInt64(-1)
+		if _, want := p.Clone(0).Div(xn.Clone(0), m); !ReduceCyclic(p, n, m).Equal(want) {
+			t.Errorf("ReduceCyclic(n = %d) differs from Div()", n)
+		}
+		xn[0].SetInt64(1)
+		if _, want := p.Clone(0).Div(xn.Clone(0), m); !ReduceNegacyclic(p, n, m).Equal(want) {
+			t.Errorf("ReduceNegacyclic(n = %d) differs from Div()", n)
+		}
+	}
+}